@@ -19,5 +19,5 @@
 
 // The following tag tells conversion-gen to generate conversion routines, and
 // it tells conversion-gen the name of the hub version.
-// +k8s:conversion-gen=github.com/HewlettPackard/dws/api/v1alpha2
+// +k8s:conversion-gen=github.com/HewlettPackard/dws/api/v1alpha3
 package v1alpha1
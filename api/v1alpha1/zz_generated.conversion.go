@@ -27,7 +27,7 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
-	v1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	v1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	dwdparse "github.com/HewlettPackard/dws/utils/dwdparse"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,655 +43,655 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
-	if err := s.AddGeneratedConversionFunc((*AllocationSetColocationConstraint)(nil), (*v1alpha2.AllocationSetColocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha2_AllocationSetColocationConstraint(a.(*AllocationSetColocationConstraint), b.(*v1alpha2.AllocationSetColocationConstraint), scope)
+	if err := s.AddGeneratedConversionFunc((*AllocationSetColocationConstraint)(nil), (*v1alpha3.AllocationSetColocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha3_AllocationSetColocationConstraint(a.(*AllocationSetColocationConstraint), b.(*v1alpha3.AllocationSetColocationConstraint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.AllocationSetColocationConstraint)(nil), (*AllocationSetColocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(a.(*v1alpha2.AllocationSetColocationConstraint), b.(*AllocationSetColocationConstraint), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.AllocationSetColocationConstraint)(nil), (*AllocationSetColocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(a.(*v1alpha3.AllocationSetColocationConstraint), b.(*AllocationSetColocationConstraint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*AllocationSetConstraints)(nil), (*v1alpha2.AllocationSetConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints(a.(*AllocationSetConstraints), b.(*v1alpha2.AllocationSetConstraints), scope)
+	if err := s.AddGeneratedConversionFunc((*AllocationSetConstraints)(nil), (*v1alpha3.AllocationSetConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints(a.(*AllocationSetConstraints), b.(*v1alpha3.AllocationSetConstraints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.AllocationSetConstraints)(nil), (*AllocationSetConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(a.(*v1alpha2.AllocationSetConstraints), b.(*AllocationSetConstraints), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.AllocationSetConstraints)(nil), (*AllocationSetConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(a.(*v1alpha3.AllocationSetConstraints), b.(*AllocationSetConstraints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMount)(nil), (*v1alpha2.ClientMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMount_To_v1alpha2_ClientMount(a.(*ClientMount), b.(*v1alpha2.ClientMount), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMount)(nil), (*v1alpha3.ClientMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMount_To_v1alpha3_ClientMount(a.(*ClientMount), b.(*v1alpha3.ClientMount), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMount)(nil), (*ClientMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMount_To_v1alpha1_ClientMount(a.(*v1alpha2.ClientMount), b.(*ClientMount), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMount)(nil), (*ClientMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMount_To_v1alpha1_ClientMount(a.(*v1alpha3.ClientMount), b.(*ClientMount), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountDevice)(nil), (*v1alpha2.ClientMountDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice(a.(*ClientMountDevice), b.(*v1alpha2.ClientMountDevice), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountDevice)(nil), (*v1alpha3.ClientMountDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice(a.(*ClientMountDevice), b.(*v1alpha3.ClientMountDevice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountDevice)(nil), (*ClientMountDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice(a.(*v1alpha2.ClientMountDevice), b.(*ClientMountDevice), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountDevice)(nil), (*ClientMountDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice(a.(*v1alpha3.ClientMountDevice), b.(*ClientMountDevice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceLVM)(nil), (*v1alpha2.ClientMountDeviceLVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha2_ClientMountDeviceLVM(a.(*ClientMountDeviceLVM), b.(*v1alpha2.ClientMountDeviceLVM), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceLVM)(nil), (*v1alpha3.ClientMountDeviceLVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha3_ClientMountDeviceLVM(a.(*ClientMountDeviceLVM), b.(*v1alpha3.ClientMountDeviceLVM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountDeviceLVM)(nil), (*ClientMountDeviceLVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(a.(*v1alpha2.ClientMountDeviceLVM), b.(*ClientMountDeviceLVM), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountDeviceLVM)(nil), (*ClientMountDeviceLVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(a.(*v1alpha3.ClientMountDeviceLVM), b.(*ClientMountDeviceLVM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceLustre)(nil), (*v1alpha2.ClientMountDeviceLustre)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha2_ClientMountDeviceLustre(a.(*ClientMountDeviceLustre), b.(*v1alpha2.ClientMountDeviceLustre), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceLustre)(nil), (*v1alpha3.ClientMountDeviceLustre)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha3_ClientMountDeviceLustre(a.(*ClientMountDeviceLustre), b.(*v1alpha3.ClientMountDeviceLustre), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountDeviceLustre)(nil), (*ClientMountDeviceLustre)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(a.(*v1alpha2.ClientMountDeviceLustre), b.(*ClientMountDeviceLustre), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountDeviceLustre)(nil), (*ClientMountDeviceLustre)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(a.(*v1alpha3.ClientMountDeviceLustre), b.(*ClientMountDeviceLustre), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceReference)(nil), (*v1alpha2.ClientMountDeviceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha2_ClientMountDeviceReference(a.(*ClientMountDeviceReference), b.(*v1alpha2.ClientMountDeviceReference), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountDeviceReference)(nil), (*v1alpha3.ClientMountDeviceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference(a.(*ClientMountDeviceReference), b.(*v1alpha3.ClientMountDeviceReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountDeviceReference)(nil), (*ClientMountDeviceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(a.(*v1alpha2.ClientMountDeviceReference), b.(*ClientMountDeviceReference), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountDeviceReference)(nil), (*ClientMountDeviceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(a.(*v1alpha3.ClientMountDeviceReference), b.(*ClientMountDeviceReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountInfo)(nil), (*v1alpha2.ClientMountInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo(a.(*ClientMountInfo), b.(*v1alpha2.ClientMountInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountInfo)(nil), (*v1alpha3.ClientMountInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo(a.(*ClientMountInfo), b.(*v1alpha3.ClientMountInfo), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountInfo)(nil), (*ClientMountInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo(a.(*v1alpha2.ClientMountInfo), b.(*ClientMountInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountInfo)(nil), (*ClientMountInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo(a.(*v1alpha3.ClientMountInfo), b.(*ClientMountInfo), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountInfoStatus)(nil), (*v1alpha2.ClientMountInfoStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha2_ClientMountInfoStatus(a.(*ClientMountInfoStatus), b.(*v1alpha2.ClientMountInfoStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountInfoStatus)(nil), (*v1alpha3.ClientMountInfoStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha3_ClientMountInfoStatus(a.(*ClientMountInfoStatus), b.(*v1alpha3.ClientMountInfoStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountInfoStatus)(nil), (*ClientMountInfoStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(a.(*v1alpha2.ClientMountInfoStatus), b.(*ClientMountInfoStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountInfoStatus)(nil), (*ClientMountInfoStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(a.(*v1alpha3.ClientMountInfoStatus), b.(*ClientMountInfoStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountList)(nil), (*v1alpha2.ClientMountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountList_To_v1alpha2_ClientMountList(a.(*ClientMountList), b.(*v1alpha2.ClientMountList), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountList)(nil), (*v1alpha3.ClientMountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountList_To_v1alpha3_ClientMountList(a.(*ClientMountList), b.(*v1alpha3.ClientMountList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountList)(nil), (*ClientMountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountList_To_v1alpha1_ClientMountList(a.(*v1alpha2.ClientMountList), b.(*ClientMountList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountList)(nil), (*ClientMountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountList_To_v1alpha1_ClientMountList(a.(*v1alpha3.ClientMountList), b.(*ClientMountList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountNVMeDesc)(nil), (*v1alpha2.ClientMountNVMeDesc)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha2_ClientMountNVMeDesc(a.(*ClientMountNVMeDesc), b.(*v1alpha2.ClientMountNVMeDesc), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountNVMeDesc)(nil), (*v1alpha3.ClientMountNVMeDesc)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha3_ClientMountNVMeDesc(a.(*ClientMountNVMeDesc), b.(*v1alpha3.ClientMountNVMeDesc), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountNVMeDesc)(nil), (*ClientMountNVMeDesc)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(a.(*v1alpha2.ClientMountNVMeDesc), b.(*ClientMountNVMeDesc), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountNVMeDesc)(nil), (*ClientMountNVMeDesc)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(a.(*v1alpha3.ClientMountNVMeDesc), b.(*ClientMountNVMeDesc), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountSpec)(nil), (*v1alpha2.ClientMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec(a.(*ClientMountSpec), b.(*v1alpha2.ClientMountSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountSpec)(nil), (*v1alpha3.ClientMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec(a.(*ClientMountSpec), b.(*v1alpha3.ClientMountSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountSpec)(nil), (*ClientMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec(a.(*v1alpha2.ClientMountSpec), b.(*ClientMountSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClientMountSpec)(nil), (*ClientMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec(a.(*v1alpha3.ClientMountSpec), b.(*ClientMountSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ClientMountStatus)(nil), (*v1alpha2.ClientMountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus(a.(*ClientMountStatus), b.(*v1alpha2.ClientMountStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ClientMountStatus)(nil), (*v1alpha3.ClientMountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus(a.(*ClientMountStatus), b.(*v1alpha3.ClientMountStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientMountStatus)(nil), (*ClientMountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus(a.(*v1alpha2.ClientMountStatus), b.(*ClientMountStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputeBreakdown)(nil), (*v1alpha3.ComputeBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeBreakdown_To_v1alpha3_ComputeBreakdown(a.(*ComputeBreakdown), b.(*v1alpha3.ComputeBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputeBreakdown)(nil), (*v1alpha2.ComputeBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputeBreakdown_To_v1alpha2_ComputeBreakdown(a.(*ComputeBreakdown), b.(*v1alpha2.ComputeBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputeBreakdown)(nil), (*ComputeBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(a.(*v1alpha3.ComputeBreakdown), b.(*ComputeBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputeBreakdown)(nil), (*ComputeBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(a.(*v1alpha2.ComputeBreakdown), b.(*ComputeBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputeConstraints)(nil), (*v1alpha3.ComputeConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints(a.(*ComputeConstraints), b.(*v1alpha3.ComputeConstraints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputeConstraints)(nil), (*v1alpha2.ComputeConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints(a.(*ComputeConstraints), b.(*v1alpha2.ComputeConstraints), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputeConstraints)(nil), (*ComputeConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints(a.(*v1alpha3.ComputeConstraints), b.(*ComputeConstraints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputeConstraints)(nil), (*ComputeConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints(a.(*v1alpha2.ComputeConstraints), b.(*ComputeConstraints), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputeLocationAccess)(nil), (*v1alpha3.ComputeLocationAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeLocationAccess_To_v1alpha3_ComputeLocationAccess(a.(*ComputeLocationAccess), b.(*v1alpha3.ComputeLocationAccess), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputeLocationAccess)(nil), (*v1alpha2.ComputeLocationAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputeLocationAccess_To_v1alpha2_ComputeLocationAccess(a.(*ComputeLocationAccess), b.(*v1alpha2.ComputeLocationAccess), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputeLocationAccess)(nil), (*ComputeLocationAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(a.(*v1alpha3.ComputeLocationAccess), b.(*ComputeLocationAccess), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputeLocationAccess)(nil), (*ComputeLocationAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(a.(*v1alpha2.ComputeLocationAccess), b.(*ComputeLocationAccess), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputeLocationConstraint)(nil), (*v1alpha3.ComputeLocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha3_ComputeLocationConstraint(a.(*ComputeLocationConstraint), b.(*v1alpha3.ComputeLocationConstraint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputeLocationConstraint)(nil), (*v1alpha2.ComputeLocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha2_ComputeLocationConstraint(a.(*ComputeLocationConstraint), b.(*v1alpha2.ComputeLocationConstraint), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputeLocationConstraint)(nil), (*ComputeLocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(a.(*v1alpha3.ComputeLocationConstraint), b.(*ComputeLocationConstraint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputeLocationConstraint)(nil), (*ComputeLocationConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(a.(*v1alpha2.ComputeLocationConstraint), b.(*ComputeLocationConstraint), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputesData)(nil), (*v1alpha3.ComputesData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputesData_To_v1alpha3_ComputesData(a.(*ComputesData), b.(*v1alpha3.ComputesData), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Computes)(nil), (*v1alpha2.Computes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Computes_To_v1alpha2_Computes(a.(*Computes), b.(*v1alpha2.Computes), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputesData)(nil), (*ComputesData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputesData_To_v1alpha1_ComputesData(a.(*v1alpha3.ComputesData), b.(*ComputesData), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.Computes)(nil), (*Computes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Computes_To_v1alpha1_Computes(a.(*v1alpha2.Computes), b.(*Computes), scope)
+	if err := s.AddGeneratedConversionFunc((*ComputesList)(nil), (*v1alpha3.ComputesList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputesList_To_v1alpha3_ComputesList(a.(*ComputesList), b.(*v1alpha3.ComputesList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputesData)(nil), (*v1alpha2.ComputesData)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputesData_To_v1alpha2_ComputesData(a.(*ComputesData), b.(*v1alpha2.ComputesData), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ComputesList)(nil), (*ComputesList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ComputesList_To_v1alpha1_ComputesList(a.(*v1alpha3.ComputesList), b.(*ComputesList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputesData)(nil), (*ComputesData)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputesData_To_v1alpha1_ComputesData(a.(*v1alpha2.ComputesData), b.(*ComputesData), scope)
+	if err := s.AddGeneratedConversionFunc((*DWDirectiveRule)(nil), (*v1alpha3.DWDirectiveRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(a.(*DWDirectiveRule), b.(*v1alpha3.DWDirectiveRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ComputesList)(nil), (*v1alpha2.ComputesList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ComputesList_To_v1alpha2_ComputesList(a.(*ComputesList), b.(*v1alpha2.ComputesList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.DWDirectiveRule)(nil), (*DWDirectiveRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(a.(*v1alpha3.DWDirectiveRule), b.(*DWDirectiveRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ComputesList)(nil), (*ComputesList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ComputesList_To_v1alpha1_ComputesList(a.(*v1alpha2.ComputesList), b.(*ComputesList), scope)
+	if err := s.AddGeneratedConversionFunc((*DWDirectiveRuleList)(nil), (*v1alpha3.DWDirectiveRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha3_DWDirectiveRuleList(a.(*DWDirectiveRuleList), b.(*v1alpha3.DWDirectiveRuleList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DWDirectiveRule)(nil), (*v1alpha2.DWDirectiveRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DWDirectiveRule_To_v1alpha2_DWDirectiveRule(a.(*DWDirectiveRule), b.(*v1alpha2.DWDirectiveRule), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.DWDirectiveRuleList)(nil), (*DWDirectiveRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(a.(*v1alpha3.DWDirectiveRuleList), b.(*DWDirectiveRuleList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DWDirectiveRule)(nil), (*DWDirectiveRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(a.(*v1alpha2.DWDirectiveRule), b.(*DWDirectiveRule), scope)
+	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdown)(nil), (*v1alpha3.DirectiveBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(a.(*DirectiveBreakdown), b.(*v1alpha3.DirectiveBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DWDirectiveRuleList)(nil), (*v1alpha2.DWDirectiveRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha2_DWDirectiveRuleList(a.(*DWDirectiveRuleList), b.(*v1alpha2.DWDirectiveRuleList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.DirectiveBreakdown)(nil), (*DirectiveBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(a.(*v1alpha3.DirectiveBreakdown), b.(*DirectiveBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DWDirectiveRuleList)(nil), (*DWDirectiveRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(a.(*v1alpha2.DWDirectiveRuleList), b.(*DWDirectiveRuleList), scope)
+	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownList)(nil), (*v1alpha3.DirectiveBreakdownList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha3_DirectiveBreakdownList(a.(*DirectiveBreakdownList), b.(*v1alpha3.DirectiveBreakdownList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdown)(nil), (*v1alpha2.DirectiveBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown(a.(*DirectiveBreakdown), b.(*v1alpha2.DirectiveBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.DirectiveBreakdownList)(nil), (*DirectiveBreakdownList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(a.(*v1alpha3.DirectiveBreakdownList), b.(*DirectiveBreakdownList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DirectiveBreakdown)(nil), (*DirectiveBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(a.(*v1alpha2.DirectiveBreakdown), b.(*DirectiveBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownSpec)(nil), (*v1alpha3.DirectiveBreakdownSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec(a.(*DirectiveBreakdownSpec), b.(*v1alpha3.DirectiveBreakdownSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownList)(nil), (*v1alpha2.DirectiveBreakdownList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha2_DirectiveBreakdownList(a.(*DirectiveBreakdownList), b.(*v1alpha2.DirectiveBreakdownList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.DirectiveBreakdownSpec)(nil), (*DirectiveBreakdownSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(a.(*v1alpha3.DirectiveBreakdownSpec), b.(*DirectiveBreakdownSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DirectiveBreakdownList)(nil), (*DirectiveBreakdownList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(a.(*v1alpha2.DirectiveBreakdownList), b.(*DirectiveBreakdownList), scope)
+	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownStatus)(nil), (*v1alpha3.DirectiveBreakdownStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus(a.(*DirectiveBreakdownStatus), b.(*v1alpha3.DirectiveBreakdownStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownSpec)(nil), (*v1alpha2.DirectiveBreakdownSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec(a.(*DirectiveBreakdownSpec), b.(*v1alpha2.DirectiveBreakdownSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*Node)(nil), (*v1alpha3.Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Node_To_v1alpha3_Node(a.(*Node), b.(*v1alpha3.Node), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DirectiveBreakdownSpec)(nil), (*DirectiveBreakdownSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(a.(*v1alpha2.DirectiveBreakdownSpec), b.(*DirectiveBreakdownSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.Node)(nil), (*Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Node_To_v1alpha1_Node(a.(*v1alpha3.Node), b.(*Node), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*DirectiveBreakdownStatus)(nil), (*v1alpha2.DirectiveBreakdownStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(a.(*DirectiveBreakdownStatus), b.(*v1alpha2.DirectiveBreakdownStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstance)(nil), (*v1alpha3.PersistentStorageInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance(a.(*PersistentStorageInstance), b.(*v1alpha3.PersistentStorageInstance), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.DirectiveBreakdownStatus)(nil), (*DirectiveBreakdownStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(a.(*v1alpha2.DirectiveBreakdownStatus), b.(*DirectiveBreakdownStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentStorageInstance)(nil), (*PersistentStorageInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(a.(*v1alpha3.PersistentStorageInstance), b.(*PersistentStorageInstance), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Node)(nil), (*v1alpha2.Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Node_To_v1alpha2_Node(a.(*Node), b.(*v1alpha2.Node), scope)
+	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceList)(nil), (*v1alpha3.PersistentStorageInstanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha3_PersistentStorageInstanceList(a.(*PersistentStorageInstanceList), b.(*v1alpha3.PersistentStorageInstanceList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.Node)(nil), (*Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Node_To_v1alpha1_Node(a.(*v1alpha2.Node), b.(*Node), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentStorageInstanceList)(nil), (*PersistentStorageInstanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(a.(*v1alpha3.PersistentStorageInstanceList), b.(*PersistentStorageInstanceList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstance)(nil), (*v1alpha2.PersistentStorageInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance(a.(*PersistentStorageInstance), b.(*v1alpha2.PersistentStorageInstance), scope)
+	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceSpec)(nil), (*v1alpha3.PersistentStorageInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec(a.(*PersistentStorageInstanceSpec), b.(*v1alpha3.PersistentStorageInstanceSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.PersistentStorageInstance)(nil), (*PersistentStorageInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(a.(*v1alpha2.PersistentStorageInstance), b.(*PersistentStorageInstance), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentStorageInstanceSpec)(nil), (*PersistentStorageInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(a.(*v1alpha3.PersistentStorageInstanceSpec), b.(*PersistentStorageInstanceSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceList)(nil), (*v1alpha2.PersistentStorageInstanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha2_PersistentStorageInstanceList(a.(*PersistentStorageInstanceList), b.(*v1alpha2.PersistentStorageInstanceList), scope)
+	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceStatus)(nil), (*v1alpha3.PersistentStorageInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus(a.(*PersistentStorageInstanceStatus), b.(*v1alpha3.PersistentStorageInstanceStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.PersistentStorageInstanceList)(nil), (*PersistentStorageInstanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(a.(*v1alpha2.PersistentStorageInstanceList), b.(*PersistentStorageInstanceList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentStorageInstanceStatus)(nil), (*PersistentStorageInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(a.(*v1alpha3.PersistentStorageInstanceStatus), b.(*PersistentStorageInstanceStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceSpec)(nil), (*v1alpha2.PersistentStorageInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec(a.(*PersistentStorageInstanceSpec), b.(*v1alpha2.PersistentStorageInstanceSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*ResourceError)(nil), (*v1alpha3.ResourceError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(a.(*ResourceError), b.(*v1alpha3.ResourceError), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.PersistentStorageInstanceSpec)(nil), (*PersistentStorageInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(a.(*v1alpha2.PersistentStorageInstanceSpec), b.(*PersistentStorageInstanceSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ResourceError)(nil), (*ResourceError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(a.(*v1alpha3.ResourceError), b.(*ResourceError), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentStorageInstanceStatus)(nil), (*v1alpha2.PersistentStorageInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus(a.(*PersistentStorageInstanceStatus), b.(*v1alpha2.PersistentStorageInstanceStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ResourceErrorInfo)(nil), (*v1alpha3.ResourceErrorInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceErrorInfo_To_v1alpha3_ResourceErrorInfo(a.(*ResourceErrorInfo), b.(*v1alpha3.ResourceErrorInfo), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.PersistentStorageInstanceStatus)(nil), (*PersistentStorageInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(a.(*v1alpha2.PersistentStorageInstanceStatus), b.(*PersistentStorageInstanceStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ResourceErrorInfo)(nil), (*ResourceErrorInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(a.(*v1alpha3.ResourceErrorInfo), b.(*ResourceErrorInfo), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ResourceError)(nil), (*v1alpha2.ResourceError)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(a.(*ResourceError), b.(*v1alpha2.ResourceError), scope)
+	if err := s.AddGeneratedConversionFunc((*Servers)(nil), (*v1alpha3.Servers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Servers_To_v1alpha3_Servers(a.(*Servers), b.(*v1alpha3.Servers), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ResourceError)(nil), (*ResourceError)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(a.(*v1alpha2.ResourceError), b.(*ResourceError), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.Servers)(nil), (*Servers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Servers_To_v1alpha1_Servers(a.(*v1alpha3.Servers), b.(*Servers), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ResourceErrorInfo)(nil), (*v1alpha2.ResourceErrorInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ResourceErrorInfo_To_v1alpha2_ResourceErrorInfo(a.(*ResourceErrorInfo), b.(*v1alpha2.ResourceErrorInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersList)(nil), (*v1alpha3.ServersList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersList_To_v1alpha3_ServersList(a.(*ServersList), b.(*v1alpha3.ServersList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ResourceErrorInfo)(nil), (*ResourceErrorInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(a.(*v1alpha2.ResourceErrorInfo), b.(*ResourceErrorInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersList)(nil), (*ServersList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersList_To_v1alpha1_ServersList(a.(*v1alpha3.ServersList), b.(*ServersList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Servers)(nil), (*v1alpha2.Servers)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Servers_To_v1alpha2_Servers(a.(*Servers), b.(*v1alpha2.Servers), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersSpec)(nil), (*v1alpha3.ServersSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec(a.(*ServersSpec), b.(*v1alpha3.ServersSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.Servers)(nil), (*Servers)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Servers_To_v1alpha1_Servers(a.(*v1alpha2.Servers), b.(*Servers), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersSpec)(nil), (*ServersSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec(a.(*v1alpha3.ServersSpec), b.(*ServersSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersList)(nil), (*v1alpha2.ServersList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersList_To_v1alpha2_ServersList(a.(*ServersList), b.(*v1alpha2.ServersList), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersSpecAllocationSet)(nil), (*v1alpha3.ServersSpecAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha3_ServersSpecAllocationSet(a.(*ServersSpecAllocationSet), b.(*v1alpha3.ServersSpecAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersList)(nil), (*ServersList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersList_To_v1alpha1_ServersList(a.(*v1alpha2.ServersList), b.(*ServersList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersSpecAllocationSet)(nil), (*ServersSpecAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(a.(*v1alpha3.ServersSpecAllocationSet), b.(*ServersSpecAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersSpec)(nil), (*v1alpha2.ServersSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec(a.(*ServersSpec), b.(*v1alpha2.ServersSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersSpecStorage)(nil), (*v1alpha3.ServersSpecStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersSpecStorage_To_v1alpha3_ServersSpecStorage(a.(*ServersSpecStorage), b.(*v1alpha3.ServersSpecStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersSpec)(nil), (*ServersSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec(a.(*v1alpha2.ServersSpec), b.(*ServersSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersSpecStorage)(nil), (*ServersSpecStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(a.(*v1alpha3.ServersSpecStorage), b.(*ServersSpecStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersSpecAllocationSet)(nil), (*v1alpha2.ServersSpecAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha2_ServersSpecAllocationSet(a.(*ServersSpecAllocationSet), b.(*v1alpha2.ServersSpecAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersStatus)(nil), (*v1alpha3.ServersStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus(a.(*ServersStatus), b.(*v1alpha3.ServersStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersSpecAllocationSet)(nil), (*ServersSpecAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(a.(*v1alpha2.ServersSpecAllocationSet), b.(*ServersSpecAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersStatusAllocationSet)(nil), (*v1alpha3.ServersStatusAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha3_ServersStatusAllocationSet(a.(*ServersStatusAllocationSet), b.(*v1alpha3.ServersStatusAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersSpecStorage)(nil), (*v1alpha2.ServersSpecStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersSpecStorage_To_v1alpha2_ServersSpecStorage(a.(*ServersSpecStorage), b.(*v1alpha2.ServersSpecStorage), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersStatusAllocationSet)(nil), (*ServersStatusAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(a.(*v1alpha3.ServersStatusAllocationSet), b.(*ServersStatusAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersSpecStorage)(nil), (*ServersSpecStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(a.(*v1alpha2.ServersSpecStorage), b.(*ServersSpecStorage), scope)
+	if err := s.AddGeneratedConversionFunc((*ServersStatusStorage)(nil), (*v1alpha3.ServersStatusStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServersStatusStorage_To_v1alpha3_ServersStatusStorage(a.(*ServersStatusStorage), b.(*v1alpha3.ServersStatusStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersStatus)(nil), (*v1alpha2.ServersStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus(a.(*ServersStatus), b.(*v1alpha2.ServersStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.ServersStatusStorage)(nil), (*ServersStatusStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(a.(*v1alpha3.ServersStatusStorage), b.(*ServersStatusStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersStatus)(nil), (*ServersStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus(a.(*v1alpha2.ServersStatus), b.(*ServersStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*v1alpha3.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_v1alpha3_Storage(a.(*Storage), b.(*v1alpha3.Storage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersStatusAllocationSet)(nil), (*v1alpha2.ServersStatusAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha2_ServersStatusAllocationSet(a.(*ServersStatusAllocationSet), b.(*v1alpha2.ServersStatusAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Storage_To_v1alpha1_Storage(a.(*v1alpha3.Storage), b.(*Storage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersStatusAllocationSet)(nil), (*ServersStatusAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(a.(*v1alpha2.ServersStatusAllocationSet), b.(*ServersStatusAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageAccess)(nil), (*v1alpha3.StorageAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess(a.(*StorageAccess), b.(*v1alpha3.StorageAccess), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServersStatusStorage)(nil), (*v1alpha2.ServersStatusStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServersStatusStorage_To_v1alpha2_ServersStatusStorage(a.(*ServersStatusStorage), b.(*v1alpha2.ServersStatusStorage), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageAccess)(nil), (*StorageAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess(a.(*v1alpha3.StorageAccess), b.(*StorageAccess), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ServersStatusStorage)(nil), (*ServersStatusStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(a.(*v1alpha2.ServersStatusStorage), b.(*ServersStatusStorage), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageAllocationSet)(nil), (*v1alpha3.StorageAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAllocationSet_To_v1alpha3_StorageAllocationSet(a.(*StorageAllocationSet), b.(*v1alpha3.StorageAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*v1alpha2.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Storage_To_v1alpha2_Storage(a.(*Storage), b.(*v1alpha2.Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageAllocationSet)(nil), (*StorageAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(a.(*v1alpha3.StorageAllocationSet), b.(*StorageAllocationSet), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Storage_To_v1alpha1_Storage(a.(*v1alpha2.Storage), b.(*Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageBreakdown)(nil), (*v1alpha3.StorageBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageBreakdown_To_v1alpha3_StorageBreakdown(a.(*StorageBreakdown), b.(*v1alpha3.StorageBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageAccess)(nil), (*v1alpha2.StorageAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess(a.(*StorageAccess), b.(*v1alpha2.StorageAccess), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageBreakdown)(nil), (*StorageBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageBreakdown_To_v1alpha1_StorageBreakdown(a.(*v1alpha3.StorageBreakdown), b.(*StorageBreakdown), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageAccess)(nil), (*StorageAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess(a.(*v1alpha2.StorageAccess), b.(*StorageAccess), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageDevice)(nil), (*v1alpha3.StorageDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageDevice_To_v1alpha3_StorageDevice(a.(*StorageDevice), b.(*v1alpha3.StorageDevice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageAllocationSet)(nil), (*v1alpha2.StorageAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageAllocationSet_To_v1alpha2_StorageAllocationSet(a.(*StorageAllocationSet), b.(*v1alpha2.StorageAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageDevice)(nil), (*StorageDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageDevice_To_v1alpha1_StorageDevice(a.(*v1alpha3.StorageDevice), b.(*StorageDevice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageAllocationSet)(nil), (*StorageAllocationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(a.(*v1alpha2.StorageAllocationSet), b.(*StorageAllocationSet), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageList)(nil), (*v1alpha3.StorageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageList_To_v1alpha3_StorageList(a.(*StorageList), b.(*v1alpha3.StorageList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageBreakdown)(nil), (*v1alpha2.StorageBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageBreakdown_To_v1alpha2_StorageBreakdown(a.(*StorageBreakdown), b.(*v1alpha2.StorageBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageList)(nil), (*StorageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageList_To_v1alpha1_StorageList(a.(*v1alpha3.StorageList), b.(*StorageList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageBreakdown)(nil), (*StorageBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageBreakdown_To_v1alpha1_StorageBreakdown(a.(*v1alpha2.StorageBreakdown), b.(*StorageBreakdown), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageSpec)(nil), (*v1alpha3.StorageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec(a.(*StorageSpec), b.(*v1alpha3.StorageSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageDevice)(nil), (*v1alpha2.StorageDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageDevice_To_v1alpha2_StorageDevice(a.(*StorageDevice), b.(*v1alpha2.StorageDevice), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageSpec)(nil), (*StorageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec(a.(*v1alpha3.StorageSpec), b.(*StorageSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageDevice)(nil), (*StorageDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice(a.(*v1alpha2.StorageDevice), b.(*StorageDevice), scope)
+	if err := s.AddGeneratedConversionFunc((*StorageStatus)(nil), (*v1alpha3.StorageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus(a.(*StorageStatus), b.(*v1alpha3.StorageStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageList)(nil), (*v1alpha2.StorageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageList_To_v1alpha2_StorageList(a.(*StorageList), b.(*v1alpha2.StorageList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.StorageStatus)(nil), (*StorageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus(a.(*v1alpha3.StorageStatus), b.(*StorageStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageList)(nil), (*StorageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageList_To_v1alpha1_StorageList(a.(*v1alpha2.StorageList), b.(*StorageList), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfiguration)(nil), (*v1alpha3.SystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfiguration_To_v1alpha3_SystemConfiguration(a.(*SystemConfiguration), b.(*v1alpha3.SystemConfiguration), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageSpec)(nil), (*v1alpha2.StorageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec(a.(*StorageSpec), b.(*v1alpha2.StorageSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfiguration)(nil), (*SystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfiguration_To_v1alpha1_SystemConfiguration(a.(*v1alpha3.SystemConfiguration), b.(*SystemConfiguration), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageSpec)(nil), (*StorageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec(a.(*v1alpha2.StorageSpec), b.(*StorageSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationComputeNode)(nil), (*v1alpha3.SystemConfigurationComputeNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha3_SystemConfigurationComputeNode(a.(*SystemConfigurationComputeNode), b.(*v1alpha3.SystemConfigurationComputeNode), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageStatus)(nil), (*v1alpha2.StorageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus(a.(*StorageStatus), b.(*v1alpha2.StorageStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationComputeNode)(nil), (*SystemConfigurationComputeNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(a.(*v1alpha3.SystemConfigurationComputeNode), b.(*SystemConfigurationComputeNode), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StorageStatus)(nil), (*StorageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(a.(*v1alpha2.StorageStatus), b.(*StorageStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationComputeNodeReference)(nil), (*v1alpha3.SystemConfigurationComputeNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha3_SystemConfigurationComputeNodeReference(a.(*SystemConfigurationComputeNodeReference), b.(*v1alpha3.SystemConfigurationComputeNodeReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfiguration)(nil), (*v1alpha2.SystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfiguration_To_v1alpha2_SystemConfiguration(a.(*SystemConfiguration), b.(*v1alpha2.SystemConfiguration), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationComputeNodeReference)(nil), (*SystemConfigurationComputeNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(a.(*v1alpha3.SystemConfigurationComputeNodeReference), b.(*SystemConfigurationComputeNodeReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfiguration)(nil), (*SystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfiguration_To_v1alpha1_SystemConfiguration(a.(*v1alpha2.SystemConfiguration), b.(*SystemConfiguration), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationList)(nil), (*v1alpha3.SystemConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationList_To_v1alpha3_SystemConfigurationList(a.(*SystemConfigurationList), b.(*v1alpha3.SystemConfigurationList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationComputeNode)(nil), (*v1alpha2.SystemConfigurationComputeNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha2_SystemConfigurationComputeNode(a.(*SystemConfigurationComputeNode), b.(*v1alpha2.SystemConfigurationComputeNode), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationList)(nil), (*SystemConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(a.(*v1alpha3.SystemConfigurationList), b.(*SystemConfigurationList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationComputeNode)(nil), (*SystemConfigurationComputeNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(a.(*v1alpha2.SystemConfigurationComputeNode), b.(*SystemConfigurationComputeNode), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationSpec)(nil), (*v1alpha3.SystemConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec(a.(*SystemConfigurationSpec), b.(*v1alpha3.SystemConfigurationSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationComputeNodeReference)(nil), (*v1alpha2.SystemConfigurationComputeNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha2_SystemConfigurationComputeNodeReference(a.(*SystemConfigurationComputeNodeReference), b.(*v1alpha2.SystemConfigurationComputeNodeReference), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationSpec)(nil), (*SystemConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(a.(*v1alpha3.SystemConfigurationSpec), b.(*SystemConfigurationSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationComputeNodeReference)(nil), (*SystemConfigurationComputeNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(a.(*v1alpha2.SystemConfigurationComputeNodeReference), b.(*SystemConfigurationComputeNodeReference), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationStatus)(nil), (*v1alpha3.SystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus(a.(*SystemConfigurationStatus), b.(*v1alpha3.SystemConfigurationStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationList)(nil), (*v1alpha2.SystemConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationList_To_v1alpha2_SystemConfigurationList(a.(*SystemConfigurationList), b.(*v1alpha2.SystemConfigurationList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationStatus)(nil), (*SystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(a.(*v1alpha3.SystemConfigurationStatus), b.(*SystemConfigurationStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationList)(nil), (*SystemConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(a.(*v1alpha2.SystemConfigurationList), b.(*SystemConfigurationList), scope)
+	if err := s.AddGeneratedConversionFunc((*SystemConfigurationStorageNode)(nil), (*v1alpha3.SystemConfigurationStorageNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha3_SystemConfigurationStorageNode(a.(*SystemConfigurationStorageNode), b.(*v1alpha3.SystemConfigurationStorageNode), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationSpec)(nil), (*v1alpha2.SystemConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec(a.(*SystemConfigurationSpec), b.(*v1alpha2.SystemConfigurationSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SystemConfigurationStorageNode)(nil), (*SystemConfigurationStorageNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(a.(*v1alpha3.SystemConfigurationStorageNode), b.(*SystemConfigurationStorageNode), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationSpec)(nil), (*SystemConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(a.(*v1alpha2.SystemConfigurationSpec), b.(*SystemConfigurationSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*Workflow)(nil), (*v1alpha3.Workflow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Workflow_To_v1alpha3_Workflow(a.(*Workflow), b.(*v1alpha3.Workflow), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationStatus)(nil), (*v1alpha2.SystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus(a.(*SystemConfigurationStatus), b.(*v1alpha2.SystemConfigurationStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.Workflow)(nil), (*Workflow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Workflow_To_v1alpha1_Workflow(a.(*v1alpha3.Workflow), b.(*Workflow), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationStatus)(nil), (*SystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(a.(*v1alpha2.SystemConfigurationStatus), b.(*SystemConfigurationStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkflowList)(nil), (*v1alpha3.WorkflowList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkflowList_To_v1alpha3_WorkflowList(a.(*WorkflowList), b.(*v1alpha3.WorkflowList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SystemConfigurationStorageNode)(nil), (*v1alpha2.SystemConfigurationStorageNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha2_SystemConfigurationStorageNode(a.(*SystemConfigurationStorageNode), b.(*v1alpha2.SystemConfigurationStorageNode), scope)
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.WorkflowList)(nil), (*WorkflowList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_WorkflowList_To_v1alpha1_WorkflowList(a.(*v1alpha3.WorkflowList), b.(*WorkflowList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.SystemConfigurationStorageNode)(nil), (*SystemConfigurationStorageNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(a.(*v1alpha2.SystemConfigurationStorageNode), b.(*SystemConfigurationStorageNode), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkflowStatus)(nil), (*v1alpha3.WorkflowStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus(a.(*WorkflowStatus), b.(*v1alpha3.WorkflowStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Workflow)(nil), (*v1alpha2.Workflow)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Workflow_To_v1alpha2_Workflow(a.(*Workflow), b.(*v1alpha2.Workflow), scope)
+	if err := s.AddConversionFunc((*Computes)(nil), (*v1alpha3.Computes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Computes_To_v1alpha3_Computes(a.(*Computes), b.(*v1alpha3.Computes), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.Workflow)(nil), (*Workflow)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Workflow_To_v1alpha1_Workflow(a.(*v1alpha2.Workflow), b.(*Workflow), scope)
+	if err := s.AddConversionFunc((*WorkflowDriverStatus)(nil), (*v1alpha3.WorkflowDriverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkflowDriverStatus_To_v1alpha3_WorkflowDriverStatus(a.(*WorkflowDriverStatus), b.(*v1alpha3.WorkflowDriverStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkflowDriverStatus)(nil), (*v1alpha2.WorkflowDriverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(a.(*WorkflowDriverStatus), b.(*v1alpha2.WorkflowDriverStatus), scope)
+	if err := s.AddConversionFunc((*WorkflowSpec)(nil), (*v1alpha3.WorkflowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkflowSpec_To_v1alpha3_WorkflowSpec(a.(*WorkflowSpec), b.(*v1alpha3.WorkflowSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkflowDriverStatus)(nil), (*WorkflowDriverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(a.(*v1alpha2.WorkflowDriverStatus), b.(*WorkflowDriverStatus), scope)
+	if err := s.AddConversionFunc((*v1alpha3.ClientMountStatus)(nil), (*ClientMountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ClientMountStatus_To_v1alpha1_ClientMountStatus(a.(*v1alpha3.ClientMountStatus), b.(*ClientMountStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkflowList)(nil), (*v1alpha2.WorkflowList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList(a.(*WorkflowList), b.(*v1alpha2.WorkflowList), scope)
+	if err := s.AddConversionFunc((*v1alpha3.Computes)(nil), (*Computes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Computes_To_v1alpha1_Computes(a.(*v1alpha3.Computes), b.(*Computes), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkflowList)(nil), (*WorkflowList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList(a.(*v1alpha2.WorkflowList), b.(*WorkflowList), scope)
+	if err := s.AddConversionFunc((*v1alpha3.DirectiveBreakdownStatus)(nil), (*DirectiveBreakdownStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(a.(*v1alpha3.DirectiveBreakdownStatus), b.(*DirectiveBreakdownStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkflowStatus)(nil), (*v1alpha2.WorkflowStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(a.(*WorkflowStatus), b.(*v1alpha2.WorkflowStatus), scope)
+	if err := s.AddConversionFunc((*v1alpha3.ServersStatus)(nil), (*ServersStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServersStatus_To_v1alpha1_ServersStatus(a.(*v1alpha3.ServersStatus), b.(*ServersStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkflowStatus)(nil), (*WorkflowStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus(a.(*v1alpha2.WorkflowStatus), b.(*WorkflowStatus), scope)
+	if err := s.AddConversionFunc((*v1alpha3.WorkflowDriverStatus)(nil), (*WorkflowDriverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(a.(*v1alpha3.WorkflowDriverStatus), b.(*WorkflowDriverStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*WorkflowSpec)(nil), (*v1alpha2.WorkflowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkflowSpec_To_v1alpha2_WorkflowSpec(a.(*WorkflowSpec), b.(*v1alpha2.WorkflowSpec), scope)
+	if err := s.AddConversionFunc((*v1alpha3.WorkflowSpec)(nil), (*WorkflowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_WorkflowSpec_To_v1alpha1_WorkflowSpec(a.(*v1alpha3.WorkflowSpec), b.(*WorkflowSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha2.WorkflowSpec)(nil), (*WorkflowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_WorkflowSpec_To_v1alpha1_WorkflowSpec(a.(*v1alpha2.WorkflowSpec), b.(*WorkflowSpec), scope)
+	if err := s.AddConversionFunc((*v1alpha3.WorkflowStatus)(nil), (*WorkflowStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_WorkflowStatus_To_v1alpha1_WorkflowStatus(a.(*v1alpha3.WorkflowStatus), b.(*WorkflowStatus), scope)
 	}); err != nil {
 		return err
 	}
 	return nil
 }
 
-func autoConvert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha2_AllocationSetColocationConstraint(in *AllocationSetColocationConstraint, out *v1alpha2.AllocationSetColocationConstraint, s conversion.Scope) error {
+func autoConvert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha3_AllocationSetColocationConstraint(in *AllocationSetColocationConstraint, out *v1alpha3.AllocationSetColocationConstraint, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Key = in.Key
 	return nil
 }
 
-// Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha2_AllocationSetColocationConstraint is an autogenerated conversion function.
-func Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha2_AllocationSetColocationConstraint(in *AllocationSetColocationConstraint, out *v1alpha2.AllocationSetColocationConstraint, s conversion.Scope) error {
-	return autoConvert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha2_AllocationSetColocationConstraint(in, out, s)
+// Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha3_AllocationSetColocationConstraint is an autogenerated conversion function.
+func Convert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha3_AllocationSetColocationConstraint(in *AllocationSetColocationConstraint, out *v1alpha3.AllocationSetColocationConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllocationSetColocationConstraint_To_v1alpha3_AllocationSetColocationConstraint(in, out, s)
 }
 
-func autoConvert_v1alpha2_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in *v1alpha2.AllocationSetColocationConstraint, out *AllocationSetColocationConstraint, s conversion.Scope) error {
+func autoConvert_v1alpha3_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in *v1alpha3.AllocationSetColocationConstraint, out *AllocationSetColocationConstraint, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Key = in.Key
 	return nil
 }
 
-// Convert_v1alpha2_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint is an autogenerated conversion function.
-func Convert_v1alpha2_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in *v1alpha2.AllocationSetColocationConstraint, out *AllocationSetColocationConstraint, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in, out, s)
+// Convert_v1alpha3_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint is an autogenerated conversion function.
+func Convert_v1alpha3_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in *v1alpha3.AllocationSetColocationConstraint, out *AllocationSetColocationConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AllocationSetColocationConstraint_To_v1alpha1_AllocationSetColocationConstraint(in, out, s)
 }
 
-func autoConvert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints(in *AllocationSetConstraints, out *v1alpha2.AllocationSetConstraints, s conversion.Scope) error {
+func autoConvert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints(in *AllocationSetConstraints, out *v1alpha3.AllocationSetConstraints, s conversion.Scope) error {
 	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
 	out.Scale = in.Scale
 	out.Count = in.Count
-	out.Colocation = *(*[]v1alpha2.AllocationSetColocationConstraint)(unsafe.Pointer(&in.Colocation))
+	out.Colocation = *(*[]v1alpha3.AllocationSetColocationConstraint)(unsafe.Pointer(&in.Colocation))
 	return nil
 }
 
-// Convert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints is an autogenerated conversion function.
-func Convert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints(in *AllocationSetConstraints, out *v1alpha2.AllocationSetConstraints, s conversion.Scope) error {
-	return autoConvert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints(in, out, s)
+// Convert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints is an autogenerated conversion function.
+func Convert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints(in *AllocationSetConstraints, out *v1alpha3.AllocationSetConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints(in, out, s)
 }
 
-func autoConvert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in *v1alpha2.AllocationSetConstraints, out *AllocationSetConstraints, s conversion.Scope) error {
+func autoConvert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in *v1alpha3.AllocationSetConstraints, out *AllocationSetConstraints, s conversion.Scope) error {
 	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
 	out.Scale = in.Scale
 	out.Count = in.Count
@@ -699,83 +699,99 @@ func autoConvert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetCons
 	return nil
 }
 
-// Convert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints is an autogenerated conversion function.
-func Convert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in *v1alpha2.AllocationSetConstraints, out *AllocationSetConstraints, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in, out, s)
+// Convert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints is an autogenerated conversion function.
+func Convert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in *v1alpha3.AllocationSetConstraints, out *AllocationSetConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMount_To_v1alpha2_ClientMount(in *ClientMount, out *v1alpha2.ClientMount, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMount_To_v1alpha3_ClientMount(in *ClientMount, out *v1alpha3.ClientMount, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMount_To_v1alpha2_ClientMount is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMount_To_v1alpha2_ClientMount(in *ClientMount, out *v1alpha2.ClientMount, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMount_To_v1alpha2_ClientMount(in, out, s)
+// Convert_v1alpha1_ClientMount_To_v1alpha3_ClientMount is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMount_To_v1alpha3_ClientMount(in *ClientMount, out *v1alpha3.ClientMount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMount_To_v1alpha3_ClientMount(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMount_To_v1alpha1_ClientMount(in *v1alpha2.ClientMount, out *ClientMount, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMount_To_v1alpha1_ClientMount(in *v1alpha3.ClientMount, out *ClientMount, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_ClientMountStatus_To_v1alpha1_ClientMountStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMount_To_v1alpha1_ClientMount is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMount_To_v1alpha1_ClientMount(in *v1alpha2.ClientMount, out *ClientMount, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMount_To_v1alpha1_ClientMount(in, out, s)
+// Convert_v1alpha3_ClientMount_To_v1alpha1_ClientMount is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMount_To_v1alpha1_ClientMount(in *v1alpha3.ClientMount, out *ClientMount, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMount_To_v1alpha1_ClientMount(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice(in *ClientMountDevice, out *v1alpha2.ClientMountDevice, s conversion.Scope) error {
-	out.Type = v1alpha2.ClientMountDeviceType(in.Type)
-	out.Lustre = (*v1alpha2.ClientMountDeviceLustre)(unsafe.Pointer(in.Lustre))
-	out.LVM = (*v1alpha2.ClientMountDeviceLVM)(unsafe.Pointer(in.LVM))
-	out.DeviceReference = (*v1alpha2.ClientMountDeviceReference)(unsafe.Pointer(in.DeviceReference))
+func autoConvert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice(in *ClientMountDevice, out *v1alpha3.ClientMountDevice, s conversion.Scope) error {
+	out.Type = v1alpha3.ClientMountDeviceType(in.Type)
+	out.Lustre = (*v1alpha3.ClientMountDeviceLustre)(unsafe.Pointer(in.Lustre))
+	out.LVM = (*v1alpha3.ClientMountDeviceLVM)(unsafe.Pointer(in.LVM))
+	if in.DeviceReference != nil {
+		in, out := &in.DeviceReference, &out.DeviceReference
+		*out = new(v1alpha3.ClientMountDeviceReference)
+		if err := Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeviceReference = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice(in *ClientMountDevice, out *v1alpha2.ClientMountDevice, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice(in, out, s)
+// Convert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice(in *ClientMountDevice, out *v1alpha3.ClientMountDevice, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice(in *v1alpha2.ClientMountDevice, out *ClientMountDevice, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice(in *v1alpha3.ClientMountDevice, out *ClientMountDevice, s conversion.Scope) error {
 	out.Type = ClientMountDeviceType(in.Type)
 	out.Lustre = (*ClientMountDeviceLustre)(unsafe.Pointer(in.Lustre))
 	out.LVM = (*ClientMountDeviceLVM)(unsafe.Pointer(in.LVM))
-	out.DeviceReference = (*ClientMountDeviceReference)(unsafe.Pointer(in.DeviceReference))
+	if in.DeviceReference != nil {
+		in, out := &in.DeviceReference, &out.DeviceReference
+		*out = new(ClientMountDeviceReference)
+		if err := Convert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeviceReference = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice(in *v1alpha2.ClientMountDevice, out *ClientMountDevice, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice(in, out, s)
+// Convert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice(in *v1alpha3.ClientMountDevice, out *ClientMountDevice, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountDeviceLVM_To_v1alpha2_ClientMountDeviceLVM(in *ClientMountDeviceLVM, out *v1alpha2.ClientMountDeviceLVM, s conversion.Scope) error {
-	out.DeviceType = v1alpha2.ClientMountLVMDeviceType(in.DeviceType)
-	out.NVMeInfo = *(*[]v1alpha2.ClientMountNVMeDesc)(unsafe.Pointer(&in.NVMeInfo))
+func autoConvert_v1alpha1_ClientMountDeviceLVM_To_v1alpha3_ClientMountDeviceLVM(in *ClientMountDeviceLVM, out *v1alpha3.ClientMountDeviceLVM, s conversion.Scope) error {
+	out.DeviceType = v1alpha3.ClientMountLVMDeviceType(in.DeviceType)
+	out.NVMeInfo = *(*[]v1alpha3.ClientMountNVMeDesc)(unsafe.Pointer(&in.NVMeInfo))
 	out.VolumeGroup = in.VolumeGroup
 	out.LogicalVolume = in.LogicalVolume
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha2_ClientMountDeviceLVM is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha2_ClientMountDeviceLVM(in *ClientMountDeviceLVM, out *v1alpha2.ClientMountDeviceLVM, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountDeviceLVM_To_v1alpha2_ClientMountDeviceLVM(in, out, s)
+// Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha3_ClientMountDeviceLVM is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountDeviceLVM_To_v1alpha3_ClientMountDeviceLVM(in *ClientMountDeviceLVM, out *v1alpha3.ClientMountDeviceLVM, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountDeviceLVM_To_v1alpha3_ClientMountDeviceLVM(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in *v1alpha2.ClientMountDeviceLVM, out *ClientMountDeviceLVM, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in *v1alpha3.ClientMountDeviceLVM, out *ClientMountDeviceLVM, s conversion.Scope) error {
 	out.DeviceType = ClientMountLVMDeviceType(in.DeviceType)
 	out.NVMeInfo = *(*[]ClientMountNVMeDesc)(unsafe.Pointer(&in.NVMeInfo))
 	out.VolumeGroup = in.VolumeGroup
@@ -783,62 +799,66 @@ func autoConvert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in *v1alpha2.ClientMountDeviceLVM, out *ClientMountDeviceLVM, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in, out, s)
+// Convert_v1alpha3_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in *v1alpha3.ClientMountDeviceLVM, out *ClientMountDeviceLVM, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountDeviceLVM_To_v1alpha1_ClientMountDeviceLVM(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountDeviceLustre_To_v1alpha2_ClientMountDeviceLustre(in *ClientMountDeviceLustre, out *v1alpha2.ClientMountDeviceLustre, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountDeviceLustre_To_v1alpha3_ClientMountDeviceLustre(in *ClientMountDeviceLustre, out *v1alpha3.ClientMountDeviceLustre, s conversion.Scope) error {
 	out.FileSystemName = in.FileSystemName
 	out.MgsAddresses = in.MgsAddresses
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha2_ClientMountDeviceLustre is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha2_ClientMountDeviceLustre(in *ClientMountDeviceLustre, out *v1alpha2.ClientMountDeviceLustre, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountDeviceLustre_To_v1alpha2_ClientMountDeviceLustre(in, out, s)
+// Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha3_ClientMountDeviceLustre is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountDeviceLustre_To_v1alpha3_ClientMountDeviceLustre(in *ClientMountDeviceLustre, out *v1alpha3.ClientMountDeviceLustre, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountDeviceLustre_To_v1alpha3_ClientMountDeviceLustre(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in *v1alpha2.ClientMountDeviceLustre, out *ClientMountDeviceLustre, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in *v1alpha3.ClientMountDeviceLustre, out *ClientMountDeviceLustre, s conversion.Scope) error {
 	out.FileSystemName = in.FileSystemName
 	out.MgsAddresses = in.MgsAddresses
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in *v1alpha2.ClientMountDeviceLustre, out *ClientMountDeviceLustre, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in, out, s)
+// Convert_v1alpha3_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in *v1alpha3.ClientMountDeviceLustre, out *ClientMountDeviceLustre, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountDeviceLustre_To_v1alpha1_ClientMountDeviceLustre(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountDeviceReference_To_v1alpha2_ClientMountDeviceReference(in *ClientMountDeviceReference, out *v1alpha2.ClientMountDeviceReference, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference(in *ClientMountDeviceReference, out *v1alpha3.ClientMountDeviceReference, s conversion.Scope) error {
 	out.ObjectReference = in.ObjectReference
-	out.Data = in.Data
+	if err := v1alpha3.Convert_int_To_string(&in.Data, &out.Data, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha2_ClientMountDeviceReference is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha2_ClientMountDeviceReference(in *ClientMountDeviceReference, out *v1alpha2.ClientMountDeviceReference, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountDeviceReference_To_v1alpha2_ClientMountDeviceReference(in, out, s)
+// Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference(in *ClientMountDeviceReference, out *v1alpha3.ClientMountDeviceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountDeviceReference_To_v1alpha3_ClientMountDeviceReference(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in *v1alpha2.ClientMountDeviceReference, out *ClientMountDeviceReference, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in *v1alpha3.ClientMountDeviceReference, out *ClientMountDeviceReference, s conversion.Scope) error {
 	out.ObjectReference = in.ObjectReference
-	out.Data = in.Data
+	if err := v1alpha3.Convert_string_To_int(&in.Data, &out.Data, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in *v1alpha2.ClientMountDeviceReference, out *ClientMountDeviceReference, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in, out, s)
+// Convert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in *v1alpha3.ClientMountDeviceReference, out *ClientMountDeviceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountDeviceReference_To_v1alpha1_ClientMountDeviceReference(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo(in *ClientMountInfo, out *v1alpha2.ClientMountInfo, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo(in *ClientMountInfo, out *v1alpha3.ClientMountInfo, s conversion.Scope) error {
 	out.MountPath = in.MountPath
 	out.UserID = in.UserID
 	out.GroupID = in.GroupID
 	out.SetPermissions = in.SetPermissions
 	out.Options = in.Options
-	if err := Convert_v1alpha1_ClientMountDevice_To_v1alpha2_ClientMountDevice(&in.Device, &out.Device, s); err != nil {
+	if err := Convert_v1alpha1_ClientMountDevice_To_v1alpha3_ClientMountDevice(&in.Device, &out.Device, s); err != nil {
 		return err
 	}
 	out.Type = in.Type
@@ -847,18 +867,18 @@ func autoConvert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo(in *Client
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo(in *ClientMountInfo, out *v1alpha2.ClientMountInfo, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountInfo_To_v1alpha2_ClientMountInfo(in, out, s)
+// Convert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo(in *ClientMountInfo, out *v1alpha3.ClientMountInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo(in *v1alpha2.ClientMountInfo, out *ClientMountInfo, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo(in *v1alpha3.ClientMountInfo, out *ClientMountInfo, s conversion.Scope) error {
 	out.MountPath = in.MountPath
 	out.UserID = in.UserID
 	out.GroupID = in.GroupID
 	out.SetPermissions = in.SetPermissions
 	out.Options = in.Options
-	if err := Convert_v1alpha2_ClientMountDevice_To_v1alpha1_ClientMountDevice(&in.Device, &out.Device, s); err != nil {
+	if err := Convert_v1alpha3_ClientMountDevice_To_v1alpha1_ClientMountDevice(&in.Device, &out.Device, s); err != nil {
 		return err
 	}
 	out.Type = in.Type
@@ -867,523 +887,626 @@ func autoConvert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo(in *v1alph
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo(in *v1alpha2.ClientMountInfo, out *ClientMountInfo, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountInfo_To_v1alpha1_ClientMountInfo(in, out, s)
+// Convert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo(in *v1alpha3.ClientMountInfo, out *ClientMountInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountInfoStatus_To_v1alpha2_ClientMountInfoStatus(in *ClientMountInfoStatus, out *v1alpha2.ClientMountInfoStatus, s conversion.Scope) error {
-	out.State = v1alpha2.ClientMountState(in.State)
+func autoConvert_v1alpha1_ClientMountInfoStatus_To_v1alpha3_ClientMountInfoStatus(in *ClientMountInfoStatus, out *v1alpha3.ClientMountInfoStatus, s conversion.Scope) error {
+	out.State = v1alpha3.ClientMountState(in.State)
 	out.Ready = in.Ready
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha2_ClientMountInfoStatus is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha2_ClientMountInfoStatus(in *ClientMountInfoStatus, out *v1alpha2.ClientMountInfoStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountInfoStatus_To_v1alpha2_ClientMountInfoStatus(in, out, s)
+// Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha3_ClientMountInfoStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountInfoStatus_To_v1alpha3_ClientMountInfoStatus(in *ClientMountInfoStatus, out *v1alpha3.ClientMountInfoStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountInfoStatus_To_v1alpha3_ClientMountInfoStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in *v1alpha2.ClientMountInfoStatus, out *ClientMountInfoStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in *v1alpha3.ClientMountInfoStatus, out *ClientMountInfoStatus, s conversion.Scope) error {
 	out.State = ClientMountState(in.State)
 	out.Ready = in.Ready
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in *v1alpha2.ClientMountInfoStatus, out *ClientMountInfoStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in, out, s)
+// Convert_v1alpha3_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in *v1alpha3.ClientMountInfoStatus, out *ClientMountInfoStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountInfoStatus_To_v1alpha1_ClientMountInfoStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountList_To_v1alpha2_ClientMountList(in *ClientMountList, out *v1alpha2.ClientMountList, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountList_To_v1alpha3_ClientMountList(in *ClientMountList, out *v1alpha3.ClientMountList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.ClientMount)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha3.ClientMount, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ClientMount_To_v1alpha3_ClientMount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountList_To_v1alpha2_ClientMountList is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountList_To_v1alpha2_ClientMountList(in *ClientMountList, out *v1alpha2.ClientMountList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountList_To_v1alpha2_ClientMountList(in, out, s)
+// Convert_v1alpha1_ClientMountList_To_v1alpha3_ClientMountList is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountList_To_v1alpha3_ClientMountList(in *ClientMountList, out *v1alpha3.ClientMountList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountList_To_v1alpha3_ClientMountList(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountList_To_v1alpha1_ClientMountList(in *v1alpha2.ClientMountList, out *ClientMountList, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountList_To_v1alpha1_ClientMountList(in *v1alpha3.ClientMountList, out *ClientMountList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClientMount)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientMount, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_ClientMount_To_v1alpha1_ClientMount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountList_To_v1alpha1_ClientMountList is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountList_To_v1alpha1_ClientMountList(in *v1alpha2.ClientMountList, out *ClientMountList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountList_To_v1alpha1_ClientMountList(in, out, s)
+// Convert_v1alpha3_ClientMountList_To_v1alpha1_ClientMountList is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountList_To_v1alpha1_ClientMountList(in *v1alpha3.ClientMountList, out *ClientMountList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountList_To_v1alpha1_ClientMountList(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountNVMeDesc_To_v1alpha2_ClientMountNVMeDesc(in *ClientMountNVMeDesc, out *v1alpha2.ClientMountNVMeDesc, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountNVMeDesc_To_v1alpha3_ClientMountNVMeDesc(in *ClientMountNVMeDesc, out *v1alpha3.ClientMountNVMeDesc, s conversion.Scope) error {
 	out.DeviceSerial = in.DeviceSerial
 	out.NamespaceID = in.NamespaceID
 	out.NamespaceGUID = in.NamespaceGUID
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha2_ClientMountNVMeDesc is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha2_ClientMountNVMeDesc(in *ClientMountNVMeDesc, out *v1alpha2.ClientMountNVMeDesc, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountNVMeDesc_To_v1alpha2_ClientMountNVMeDesc(in, out, s)
+// Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha3_ClientMountNVMeDesc is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountNVMeDesc_To_v1alpha3_ClientMountNVMeDesc(in *ClientMountNVMeDesc, out *v1alpha3.ClientMountNVMeDesc, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountNVMeDesc_To_v1alpha3_ClientMountNVMeDesc(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in *v1alpha2.ClientMountNVMeDesc, out *ClientMountNVMeDesc, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in *v1alpha3.ClientMountNVMeDesc, out *ClientMountNVMeDesc, s conversion.Scope) error {
 	out.DeviceSerial = in.DeviceSerial
 	out.NamespaceID = in.NamespaceID
 	out.NamespaceGUID = in.NamespaceGUID
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in *v1alpha2.ClientMountNVMeDesc, out *ClientMountNVMeDesc, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in, out, s)
+// Convert_v1alpha3_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in *v1alpha3.ClientMountNVMeDesc, out *ClientMountNVMeDesc, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountNVMeDesc_To_v1alpha1_ClientMountNVMeDesc(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec(in *ClientMountSpec, out *v1alpha2.ClientMountSpec, s conversion.Scope) error {
+func autoConvert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec(in *ClientMountSpec, out *v1alpha3.ClientMountSpec, s conversion.Scope) error {
 	out.Node = in.Node
-	out.DesiredState = v1alpha2.ClientMountState(in.DesiredState)
-	out.Mounts = *(*[]v1alpha2.ClientMountInfo)(unsafe.Pointer(&in.Mounts))
+	out.DesiredState = v1alpha3.ClientMountState(in.DesiredState)
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]v1alpha3.ClientMountInfo, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ClientMountInfo_To_v1alpha3_ClientMountInfo(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Mounts = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec(in *ClientMountSpec, out *v1alpha2.ClientMountSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountSpec_To_v1alpha2_ClientMountSpec(in, out, s)
+// Convert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec(in *ClientMountSpec, out *v1alpha3.ClientMountSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountSpec_To_v1alpha3_ClientMountSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec(in *v1alpha2.ClientMountSpec, out *ClientMountSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec(in *v1alpha3.ClientMountSpec, out *ClientMountSpec, s conversion.Scope) error {
 	out.Node = in.Node
 	out.DesiredState = ClientMountState(in.DesiredState)
-	out.Mounts = *(*[]ClientMountInfo)(unsafe.Pointer(&in.Mounts))
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]ClientMountInfo, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_ClientMountInfo_To_v1alpha1_ClientMountInfo(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Mounts = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec(in *v1alpha2.ClientMountSpec, out *ClientMountSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountSpec_To_v1alpha1_ClientMountSpec(in, out, s)
+// Convert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec is an autogenerated conversion function.
+func Convert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec(in *v1alpha3.ClientMountSpec, out *ClientMountSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountSpec_To_v1alpha1_ClientMountSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus(in *ClientMountStatus, out *v1alpha2.ClientMountStatus, s conversion.Scope) error {
-	out.Mounts = *(*[]v1alpha2.ClientMountInfoStatus)(unsafe.Pointer(&in.Mounts))
-	if err := Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+func autoConvert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus(in *ClientMountStatus, out *v1alpha3.ClientMountStatus, s conversion.Scope) error {
+	out.Mounts = *(*[]v1alpha3.ClientMountInfoStatus)(unsafe.Pointer(&in.Mounts))
+	if err := Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus is an autogenerated conversion function.
-func Convert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus(in *ClientMountStatus, out *v1alpha2.ClientMountStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ClientMountStatus_To_v1alpha2_ClientMountStatus(in, out, s)
+// Convert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus(in *ClientMountStatus, out *v1alpha3.ClientMountStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientMountStatus_To_v1alpha3_ClientMountStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus(in *v1alpha2.ClientMountStatus, out *ClientMountStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_ClientMountStatus_To_v1alpha1_ClientMountStatus(in *v1alpha3.ClientMountStatus, out *ClientMountStatus, s conversion.Scope) error {
 	out.Mounts = *(*[]ClientMountInfoStatus)(unsafe.Pointer(&in.Mounts))
-	if err := Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
+	if err := Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus is an autogenerated conversion function.
-func Convert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus(in *v1alpha2.ClientMountStatus, out *ClientMountStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClientMountStatus_To_v1alpha1_ClientMountStatus(in, out, s)
-}
-
-func autoConvert_v1alpha1_ComputeBreakdown_To_v1alpha2_ComputeBreakdown(in *ComputeBreakdown, out *v1alpha2.ComputeBreakdown, s conversion.Scope) error {
-	if err := Convert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+func autoConvert_v1alpha1_ComputeBreakdown_To_v1alpha3_ComputeBreakdown(in *ComputeBreakdown, out *v1alpha3.ComputeBreakdown, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints(&in.Constraints, &out.Constraints, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_ComputeBreakdown_To_v1alpha2_ComputeBreakdown is an autogenerated conversion function.
-func Convert_v1alpha1_ComputeBreakdown_To_v1alpha2_ComputeBreakdown(in *ComputeBreakdown, out *v1alpha2.ComputeBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputeBreakdown_To_v1alpha2_ComputeBreakdown(in, out, s)
+// Convert_v1alpha1_ComputeBreakdown_To_v1alpha3_ComputeBreakdown is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeBreakdown_To_v1alpha3_ComputeBreakdown(in *ComputeBreakdown, out *v1alpha3.ComputeBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeBreakdown_To_v1alpha3_ComputeBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in *v1alpha2.ComputeBreakdown, out *ComputeBreakdown, s conversion.Scope) error {
-	if err := Convert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+func autoConvert_v1alpha3_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in *v1alpha3.ComputeBreakdown, out *ComputeBreakdown, s conversion.Scope) error {
+	if err := Convert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints(&in.Constraints, &out.Constraints, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_ComputeBreakdown_To_v1alpha1_ComputeBreakdown is an autogenerated conversion function.
-func Convert_v1alpha2_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in *v1alpha2.ComputeBreakdown, out *ComputeBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in, out, s)
+// Convert_v1alpha3_ComputeBreakdown_To_v1alpha1_ComputeBreakdown is an autogenerated conversion function.
+func Convert_v1alpha3_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in *v1alpha3.ComputeBreakdown, out *ComputeBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputeBreakdown_To_v1alpha1_ComputeBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints(in *ComputeConstraints, out *v1alpha2.ComputeConstraints, s conversion.Scope) error {
-	out.Location = *(*[]v1alpha2.ComputeLocationConstraint)(unsafe.Pointer(&in.Location))
+func autoConvert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints(in *ComputeConstraints, out *v1alpha3.ComputeConstraints, s conversion.Scope) error {
+	out.Location = *(*[]v1alpha3.ComputeLocationConstraint)(unsafe.Pointer(&in.Location))
 	return nil
 }
 
-// Convert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints is an autogenerated conversion function.
-func Convert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints(in *ComputeConstraints, out *v1alpha2.ComputeConstraints, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputeConstraints_To_v1alpha2_ComputeConstraints(in, out, s)
+// Convert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints(in *ComputeConstraints, out *v1alpha3.ComputeConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeConstraints_To_v1alpha3_ComputeConstraints(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints(in *v1alpha2.ComputeConstraints, out *ComputeConstraints, s conversion.Scope) error {
+func autoConvert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints(in *v1alpha3.ComputeConstraints, out *ComputeConstraints, s conversion.Scope) error {
 	out.Location = *(*[]ComputeLocationConstraint)(unsafe.Pointer(&in.Location))
 	return nil
 }
 
-// Convert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints is an autogenerated conversion function.
-func Convert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints(in *v1alpha2.ComputeConstraints, out *ComputeConstraints, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputeConstraints_To_v1alpha1_ComputeConstraints(in, out, s)
+// Convert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints is an autogenerated conversion function.
+func Convert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints(in *v1alpha3.ComputeConstraints, out *ComputeConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputeConstraints_To_v1alpha1_ComputeConstraints(in, out, s)
 }
 
-func autoConvert_v1alpha1_ComputeLocationAccess_To_v1alpha2_ComputeLocationAccess(in *ComputeLocationAccess, out *v1alpha2.ComputeLocationAccess, s conversion.Scope) error {
-	out.Type = v1alpha2.ComputeLocationType(in.Type)
-	out.Priority = v1alpha2.ComputeLocationPriority(in.Priority)
+func autoConvert_v1alpha1_ComputeLocationAccess_To_v1alpha3_ComputeLocationAccess(in *ComputeLocationAccess, out *v1alpha3.ComputeLocationAccess, s conversion.Scope) error {
+	out.Type = v1alpha3.ComputeLocationType(in.Type)
+	out.Priority = v1alpha3.ComputeLocationPriority(in.Priority)
 	return nil
 }
 
-// Convert_v1alpha1_ComputeLocationAccess_To_v1alpha2_ComputeLocationAccess is an autogenerated conversion function.
-func Convert_v1alpha1_ComputeLocationAccess_To_v1alpha2_ComputeLocationAccess(in *ComputeLocationAccess, out *v1alpha2.ComputeLocationAccess, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputeLocationAccess_To_v1alpha2_ComputeLocationAccess(in, out, s)
+// Convert_v1alpha1_ComputeLocationAccess_To_v1alpha3_ComputeLocationAccess is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeLocationAccess_To_v1alpha3_ComputeLocationAccess(in *ComputeLocationAccess, out *v1alpha3.ComputeLocationAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeLocationAccess_To_v1alpha3_ComputeLocationAccess(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in *v1alpha2.ComputeLocationAccess, out *ComputeLocationAccess, s conversion.Scope) error {
+func autoConvert_v1alpha3_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in *v1alpha3.ComputeLocationAccess, out *ComputeLocationAccess, s conversion.Scope) error {
 	out.Type = ComputeLocationType(in.Type)
 	out.Priority = ComputeLocationPriority(in.Priority)
 	return nil
 }
 
-// Convert_v1alpha2_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess is an autogenerated conversion function.
-func Convert_v1alpha2_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in *v1alpha2.ComputeLocationAccess, out *ComputeLocationAccess, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in, out, s)
+// Convert_v1alpha3_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess is an autogenerated conversion function.
+func Convert_v1alpha3_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in *v1alpha3.ComputeLocationAccess, out *ComputeLocationAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputeLocationAccess_To_v1alpha1_ComputeLocationAccess(in, out, s)
 }
 
-func autoConvert_v1alpha1_ComputeLocationConstraint_To_v1alpha2_ComputeLocationConstraint(in *ComputeLocationConstraint, out *v1alpha2.ComputeLocationConstraint, s conversion.Scope) error {
-	out.Access = *(*[]v1alpha2.ComputeLocationAccess)(unsafe.Pointer(&in.Access))
+func autoConvert_v1alpha1_ComputeLocationConstraint_To_v1alpha3_ComputeLocationConstraint(in *ComputeLocationConstraint, out *v1alpha3.ComputeLocationConstraint, s conversion.Scope) error {
+	out.Access = *(*[]v1alpha3.ComputeLocationAccess)(unsafe.Pointer(&in.Access))
 	out.Reference = in.Reference
 	return nil
 }
 
-// Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha2_ComputeLocationConstraint is an autogenerated conversion function.
-func Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha2_ComputeLocationConstraint(in *ComputeLocationConstraint, out *v1alpha2.ComputeLocationConstraint, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputeLocationConstraint_To_v1alpha2_ComputeLocationConstraint(in, out, s)
+// Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha3_ComputeLocationConstraint is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeLocationConstraint_To_v1alpha3_ComputeLocationConstraint(in *ComputeLocationConstraint, out *v1alpha3.ComputeLocationConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeLocationConstraint_To_v1alpha3_ComputeLocationConstraint(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in *v1alpha2.ComputeLocationConstraint, out *ComputeLocationConstraint, s conversion.Scope) error {
+func autoConvert_v1alpha3_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in *v1alpha3.ComputeLocationConstraint, out *ComputeLocationConstraint, s conversion.Scope) error {
 	out.Access = *(*[]ComputeLocationAccess)(unsafe.Pointer(&in.Access))
 	out.Reference = in.Reference
 	return nil
 }
 
-// Convert_v1alpha2_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint is an autogenerated conversion function.
-func Convert_v1alpha2_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in *v1alpha2.ComputeLocationConstraint, out *ComputeLocationConstraint, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in, out, s)
+// Convert_v1alpha3_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint is an autogenerated conversion function.
+func Convert_v1alpha3_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in *v1alpha3.ComputeLocationConstraint, out *ComputeLocationConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputeLocationConstraint_To_v1alpha1_ComputeLocationConstraint(in, out, s)
 }
 
-func autoConvert_v1alpha1_Computes_To_v1alpha2_Computes(in *Computes, out *v1alpha2.Computes, s conversion.Scope) error {
+func autoConvert_v1alpha1_Computes_To_v1alpha3_Computes(in *Computes, out *v1alpha3.Computes, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*[]v1alpha2.ComputesData)(unsafe.Pointer(&in.Data))
+	// WARNING: in.Data requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha1_Computes_To_v1alpha2_Computes is an autogenerated conversion function.
-func Convert_v1alpha1_Computes_To_v1alpha2_Computes(in *Computes, out *v1alpha2.Computes, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Computes_To_v1alpha2_Computes(in, out, s)
-}
-
-func autoConvert_v1alpha2_Computes_To_v1alpha1_Computes(in *v1alpha2.Computes, out *Computes, s conversion.Scope) error {
+func autoConvert_v1alpha3_Computes_To_v1alpha1_Computes(in *v1alpha3.Computes, out *Computes, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*[]ComputesData)(unsafe.Pointer(&in.Data))
+	// WARNING: in.Spec requires manual conversion: does not exist in peer-type
+	// WARNING: in.Status requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_Computes_To_v1alpha1_Computes is an autogenerated conversion function.
-func Convert_v1alpha2_Computes_To_v1alpha1_Computes(in *v1alpha2.Computes, out *Computes, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Computes_To_v1alpha1_Computes(in, out, s)
-}
-
-func autoConvert_v1alpha1_ComputesData_To_v1alpha2_ComputesData(in *ComputesData, out *v1alpha2.ComputesData, s conversion.Scope) error {
+func autoConvert_v1alpha1_ComputesData_To_v1alpha3_ComputesData(in *ComputesData, out *v1alpha3.ComputesData, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha1_ComputesData_To_v1alpha2_ComputesData is an autogenerated conversion function.
-func Convert_v1alpha1_ComputesData_To_v1alpha2_ComputesData(in *ComputesData, out *v1alpha2.ComputesData, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputesData_To_v1alpha2_ComputesData(in, out, s)
+// Convert_v1alpha1_ComputesData_To_v1alpha3_ComputesData is an autogenerated conversion function.
+func Convert_v1alpha1_ComputesData_To_v1alpha3_ComputesData(in *ComputesData, out *v1alpha3.ComputesData, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputesData_To_v1alpha3_ComputesData(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputesData_To_v1alpha1_ComputesData(in *v1alpha2.ComputesData, out *ComputesData, s conversion.Scope) error {
+func autoConvert_v1alpha3_ComputesData_To_v1alpha1_ComputesData(in *v1alpha3.ComputesData, out *ComputesData, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha2_ComputesData_To_v1alpha1_ComputesData is an autogenerated conversion function.
-func Convert_v1alpha2_ComputesData_To_v1alpha1_ComputesData(in *v1alpha2.ComputesData, out *ComputesData, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputesData_To_v1alpha1_ComputesData(in, out, s)
+// Convert_v1alpha3_ComputesData_To_v1alpha1_ComputesData is an autogenerated conversion function.
+func Convert_v1alpha3_ComputesData_To_v1alpha1_ComputesData(in *v1alpha3.ComputesData, out *ComputesData, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputesData_To_v1alpha1_ComputesData(in, out, s)
 }
 
-func autoConvert_v1alpha1_ComputesList_To_v1alpha2_ComputesList(in *ComputesList, out *v1alpha2.ComputesList, s conversion.Scope) error {
+func autoConvert_v1alpha1_ComputesList_To_v1alpha3_ComputesList(in *ComputesList, out *v1alpha3.ComputesList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.Computes)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha3.Computes, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Computes_To_v1alpha3_Computes(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ComputesList_To_v1alpha2_ComputesList is an autogenerated conversion function.
-func Convert_v1alpha1_ComputesList_To_v1alpha2_ComputesList(in *ComputesList, out *v1alpha2.ComputesList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ComputesList_To_v1alpha2_ComputesList(in, out, s)
+// Convert_v1alpha1_ComputesList_To_v1alpha3_ComputesList is an autogenerated conversion function.
+func Convert_v1alpha1_ComputesList_To_v1alpha3_ComputesList(in *ComputesList, out *v1alpha3.ComputesList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputesList_To_v1alpha3_ComputesList(in, out, s)
 }
 
-func autoConvert_v1alpha2_ComputesList_To_v1alpha1_ComputesList(in *v1alpha2.ComputesList, out *ComputesList, s conversion.Scope) error {
+func autoConvert_v1alpha3_ComputesList_To_v1alpha1_ComputesList(in *v1alpha3.ComputesList, out *ComputesList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Computes)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Computes, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_Computes_To_v1alpha1_Computes(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ComputesList_To_v1alpha1_ComputesList is an autogenerated conversion function.
-func Convert_v1alpha2_ComputesList_To_v1alpha1_ComputesList(in *v1alpha2.ComputesList, out *ComputesList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ComputesList_To_v1alpha1_ComputesList(in, out, s)
+// Convert_v1alpha3_ComputesList_To_v1alpha1_ComputesList is an autogenerated conversion function.
+func Convert_v1alpha3_ComputesList_To_v1alpha1_ComputesList(in *v1alpha3.ComputesList, out *ComputesList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ComputesList_To_v1alpha1_ComputesList(in, out, s)
 }
 
-func autoConvert_v1alpha1_DWDirectiveRule_To_v1alpha2_DWDirectiveRule(in *DWDirectiveRule, out *v1alpha2.DWDirectiveRule, s conversion.Scope) error {
+func autoConvert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(in *DWDirectiveRule, out *v1alpha3.DWDirectiveRule, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Spec = *(*[]dwdparse.DWDirectiveRuleSpec)(unsafe.Pointer(&in.Spec))
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = make([]v1alpha3.DWDirectiveRuleSpec, len(*in))
+		for i := range *in {
+			if err := v1alpha3.Convert_dwdparse_DWDirectiveRuleSpec_To_v1alpha3_DWDirectiveRuleSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Spec = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_DWDirectiveRule_To_v1alpha2_DWDirectiveRule is an autogenerated conversion function.
-func Convert_v1alpha1_DWDirectiveRule_To_v1alpha2_DWDirectiveRule(in *DWDirectiveRule, out *v1alpha2.DWDirectiveRule, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DWDirectiveRule_To_v1alpha2_DWDirectiveRule(in, out, s)
+// Convert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule is an autogenerated conversion function.
+func Convert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(in *DWDirectiveRule, out *v1alpha3.DWDirectiveRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(in, out, s)
 }
 
-func autoConvert_v1alpha2_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in *v1alpha2.DWDirectiveRule, out *DWDirectiveRule, s conversion.Scope) error {
+func autoConvert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in *v1alpha3.DWDirectiveRule, out *DWDirectiveRule, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Spec = *(*[]dwdparse.DWDirectiveRuleSpec)(unsafe.Pointer(&in.Spec))
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = make([]dwdparse.DWDirectiveRuleSpec, len(*in))
+		for i := range *in {
+			if err := v1alpha3.Convert_v1alpha3_DWDirectiveRuleSpec_To_dwdparse_DWDirectiveRuleSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Spec = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_DWDirectiveRule_To_v1alpha1_DWDirectiveRule is an autogenerated conversion function.
-func Convert_v1alpha2_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in *v1alpha2.DWDirectiveRule, out *DWDirectiveRule, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in, out, s)
+// Convert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule is an autogenerated conversion function.
+func Convert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in *v1alpha3.DWDirectiveRule, out *DWDirectiveRule, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(in, out, s)
 }
 
-func autoConvert_v1alpha1_DWDirectiveRuleList_To_v1alpha2_DWDirectiveRuleList(in *DWDirectiveRuleList, out *v1alpha2.DWDirectiveRuleList, s conversion.Scope) error {
+func autoConvert_v1alpha1_DWDirectiveRuleList_To_v1alpha3_DWDirectiveRuleList(in *DWDirectiveRuleList, out *v1alpha3.DWDirectiveRuleList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.DWDirectiveRule)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha3.DWDirectiveRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha2_DWDirectiveRuleList is an autogenerated conversion function.
-func Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha2_DWDirectiveRuleList(in *DWDirectiveRuleList, out *v1alpha2.DWDirectiveRuleList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DWDirectiveRuleList_To_v1alpha2_DWDirectiveRuleList(in, out, s)
+// Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha3_DWDirectiveRuleList is an autogenerated conversion function.
+func Convert_v1alpha1_DWDirectiveRuleList_To_v1alpha3_DWDirectiveRuleList(in *DWDirectiveRuleList, out *v1alpha3.DWDirectiveRuleList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DWDirectiveRuleList_To_v1alpha3_DWDirectiveRuleList(in, out, s)
 }
 
-func autoConvert_v1alpha2_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in *v1alpha2.DWDirectiveRuleList, out *DWDirectiveRuleList, s conversion.Scope) error {
+func autoConvert_v1alpha3_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in *v1alpha3.DWDirectiveRuleList, out *DWDirectiveRuleList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]DWDirectiveRule)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DWDirectiveRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_DWDirectiveRule_To_v1alpha1_DWDirectiveRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList is an autogenerated conversion function.
-func Convert_v1alpha2_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in *v1alpha2.DWDirectiveRuleList, out *DWDirectiveRuleList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in, out, s)
+// Convert_v1alpha3_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList is an autogenerated conversion function.
+func Convert_v1alpha3_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in *v1alpha3.DWDirectiveRuleList, out *DWDirectiveRuleList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DWDirectiveRuleList_To_v1alpha1_DWDirectiveRuleList(in, out, s)
 }
 
-func autoConvert_v1alpha1_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown(in *DirectiveBreakdown, out *v1alpha2.DirectiveBreakdown, s conversion.Scope) error {
+func autoConvert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(in *DirectiveBreakdown, out *v1alpha3.DirectiveBreakdown, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown is an autogenerated conversion function.
-func Convert_v1alpha1_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown(in *DirectiveBreakdown, out *v1alpha2.DirectiveBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown(in, out, s)
+// Convert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown is an autogenerated conversion function.
+func Convert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(in *DirectiveBreakdown, out *v1alpha3.DirectiveBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha2_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in *v1alpha2.DirectiveBreakdown, out *DirectiveBreakdown, s conversion.Scope) error {
+func autoConvert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in *v1alpha3.DirectiveBreakdown, out *DirectiveBreakdown, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown is an autogenerated conversion function.
-func Convert_v1alpha2_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in *v1alpha2.DirectiveBreakdown, out *DirectiveBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in, out, s)
+// Convert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown is an autogenerated conversion function.
+func Convert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in *v1alpha3.DirectiveBreakdown, out *DirectiveBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha1_DirectiveBreakdownList_To_v1alpha2_DirectiveBreakdownList(in *DirectiveBreakdownList, out *v1alpha2.DirectiveBreakdownList, s conversion.Scope) error {
+func autoConvert_v1alpha1_DirectiveBreakdownList_To_v1alpha3_DirectiveBreakdownList(in *DirectiveBreakdownList, out *v1alpha3.DirectiveBreakdownList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.DirectiveBreakdown)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha3.DirectiveBreakdown, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha2_DirectiveBreakdownList is an autogenerated conversion function.
-func Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha2_DirectiveBreakdownList(in *DirectiveBreakdownList, out *v1alpha2.DirectiveBreakdownList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DirectiveBreakdownList_To_v1alpha2_DirectiveBreakdownList(in, out, s)
+// Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha3_DirectiveBreakdownList is an autogenerated conversion function.
+func Convert_v1alpha1_DirectiveBreakdownList_To_v1alpha3_DirectiveBreakdownList(in *DirectiveBreakdownList, out *v1alpha3.DirectiveBreakdownList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DirectiveBreakdownList_To_v1alpha3_DirectiveBreakdownList(in, out, s)
 }
 
-func autoConvert_v1alpha2_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in *v1alpha2.DirectiveBreakdownList, out *DirectiveBreakdownList, s conversion.Scope) error {
+func autoConvert_v1alpha3_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in *v1alpha3.DirectiveBreakdownList, out *DirectiveBreakdownList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]DirectiveBreakdown)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectiveBreakdown, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_DirectiveBreakdown_To_v1alpha1_DirectiveBreakdown(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList is an autogenerated conversion function.
-func Convert_v1alpha2_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in *v1alpha2.DirectiveBreakdownList, out *DirectiveBreakdownList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in, out, s)
+// Convert_v1alpha3_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList is an autogenerated conversion function.
+func Convert_v1alpha3_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in *v1alpha3.DirectiveBreakdownList, out *DirectiveBreakdownList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DirectiveBreakdownList_To_v1alpha1_DirectiveBreakdownList(in, out, s)
 }
 
-func autoConvert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec(in *DirectiveBreakdownSpec, out *v1alpha2.DirectiveBreakdownSpec, s conversion.Scope) error {
+func autoConvert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec(in *DirectiveBreakdownSpec, out *v1alpha3.DirectiveBreakdownSpec, s conversion.Scope) error {
 	out.Directive = in.Directive
 	out.UserID = in.UserID
 	return nil
 }
 
-// Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec is an autogenerated conversion function.
-func Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec(in *DirectiveBreakdownSpec, out *v1alpha2.DirectiveBreakdownSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha2_DirectiveBreakdownSpec(in, out, s)
+// Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec(in *DirectiveBreakdownSpec, out *v1alpha3.DirectiveBreakdownSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DirectiveBreakdownSpec_To_v1alpha3_DirectiveBreakdownSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in *v1alpha2.DirectiveBreakdownSpec, out *DirectiveBreakdownSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in *v1alpha3.DirectiveBreakdownSpec, out *DirectiveBreakdownSpec, s conversion.Scope) error {
 	out.Directive = in.Directive
 	out.UserID = in.UserID
 	return nil
 }
 
-// Convert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec is an autogenerated conversion function.
-func Convert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in *v1alpha2.DirectiveBreakdownSpec, out *DirectiveBreakdownSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in, out, s)
+// Convert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec is an autogenerated conversion function.
+func Convert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in *v1alpha3.DirectiveBreakdownSpec, out *DirectiveBreakdownSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DirectiveBreakdownSpec_To_v1alpha1_DirectiveBreakdownSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(in *DirectiveBreakdownStatus, out *v1alpha2.DirectiveBreakdownStatus, s conversion.Scope) error {
-	out.Storage = (*v1alpha2.StorageBreakdown)(unsafe.Pointer(in.Storage))
-	out.Compute = (*v1alpha2.ComputeBreakdown)(unsafe.Pointer(in.Compute))
+func autoConvert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus(in *DirectiveBreakdownStatus, out *v1alpha3.DirectiveBreakdownStatus, s conversion.Scope) error {
+	out.Storage = (*v1alpha3.StorageBreakdown)(unsafe.Pointer(in.Storage))
+	out.Compute = (*v1alpha3.ComputeBreakdown)(unsafe.Pointer(in.Compute))
 	out.Ready = in.Ready
-	if err := Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+	if err := Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus is an autogenerated conversion function.
-func Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(in *DirectiveBreakdownStatus, out *v1alpha2.DirectiveBreakdownStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(in, out, s)
+// Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus(in *DirectiveBreakdownStatus, out *v1alpha3.DirectiveBreakdownStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DirectiveBreakdownStatus_To_v1alpha3_DirectiveBreakdownStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(in *v1alpha2.DirectiveBreakdownStatus, out *DirectiveBreakdownStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(in *v1alpha3.DirectiveBreakdownStatus, out *DirectiveBreakdownStatus, s conversion.Scope) error {
 	out.Storage = (*StorageBreakdown)(unsafe.Pointer(in.Storage))
 	out.Compute = (*ComputeBreakdown)(unsafe.Pointer(in.Compute))
 	out.Ready = in.Ready
-	if err := Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
+	if err := Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus is an autogenerated conversion function.
-func Convert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(in *v1alpha2.DirectiveBreakdownStatus, out *DirectiveBreakdownStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_DirectiveBreakdownStatus_To_v1alpha1_DirectiveBreakdownStatus(in, out, s)
-}
-
-func autoConvert_v1alpha1_Node_To_v1alpha2_Node(in *Node, out *v1alpha2.Node, s conversion.Scope) error {
+func autoConvert_v1alpha1_Node_To_v1alpha3_Node(in *Node, out *v1alpha3.Node, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Status = v1alpha2.ResourceStatus(in.Status)
+	out.Status = v1alpha3.ResourceStatus(in.Status)
 	return nil
 }
 
-// Convert_v1alpha1_Node_To_v1alpha2_Node is an autogenerated conversion function.
-func Convert_v1alpha1_Node_To_v1alpha2_Node(in *Node, out *v1alpha2.Node, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Node_To_v1alpha2_Node(in, out, s)
+// Convert_v1alpha1_Node_To_v1alpha3_Node is an autogenerated conversion function.
+func Convert_v1alpha1_Node_To_v1alpha3_Node(in *Node, out *v1alpha3.Node, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Node_To_v1alpha3_Node(in, out, s)
 }
 
-func autoConvert_v1alpha2_Node_To_v1alpha1_Node(in *v1alpha2.Node, out *Node, s conversion.Scope) error {
+func autoConvert_v1alpha3_Node_To_v1alpha1_Node(in *v1alpha3.Node, out *Node, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Status = ResourceStatus(in.Status)
 	return nil
 }
 
-// Convert_v1alpha2_Node_To_v1alpha1_Node is an autogenerated conversion function.
-func Convert_v1alpha2_Node_To_v1alpha1_Node(in *v1alpha2.Node, out *Node, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Node_To_v1alpha1_Node(in, out, s)
+// Convert_v1alpha3_Node_To_v1alpha1_Node is an autogenerated conversion function.
+func Convert_v1alpha3_Node_To_v1alpha1_Node(in *v1alpha3.Node, out *Node, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Node_To_v1alpha1_Node(in, out, s)
 }
 
-func autoConvert_v1alpha1_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance(in *PersistentStorageInstance, out *v1alpha2.PersistentStorageInstance, s conversion.Scope) error {
+func autoConvert_v1alpha1_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance(in *PersistentStorageInstance, out *v1alpha3.PersistentStorageInstance, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance is an autogenerated conversion function.
-func Convert_v1alpha1_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance(in *PersistentStorageInstance, out *v1alpha2.PersistentStorageInstance, s conversion.Scope) error {
-	return autoConvert_v1alpha1_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance(in, out, s)
+// Convert_v1alpha1_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance(in *PersistentStorageInstance, out *v1alpha3.PersistentStorageInstance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance(in, out, s)
 }
 
-func autoConvert_v1alpha2_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in *v1alpha2.PersistentStorageInstance, out *PersistentStorageInstance, s conversion.Scope) error {
+func autoConvert_v1alpha3_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in *v1alpha3.PersistentStorageInstance, out *PersistentStorageInstance, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance is an autogenerated conversion function.
-func Convert_v1alpha2_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in *v1alpha2.PersistentStorageInstance, out *PersistentStorageInstance, s conversion.Scope) error {
-	return autoConvert_v1alpha2_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in, out, s)
+// Convert_v1alpha3_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance is an autogenerated conversion function.
+func Convert_v1alpha3_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in *v1alpha3.PersistentStorageInstance, out *PersistentStorageInstance, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PersistentStorageInstance_To_v1alpha1_PersistentStorageInstance(in, out, s)
 }
 
-func autoConvert_v1alpha1_PersistentStorageInstanceList_To_v1alpha2_PersistentStorageInstanceList(in *PersistentStorageInstanceList, out *v1alpha2.PersistentStorageInstanceList, s conversion.Scope) error {
+func autoConvert_v1alpha1_PersistentStorageInstanceList_To_v1alpha3_PersistentStorageInstanceList(in *PersistentStorageInstanceList, out *v1alpha3.PersistentStorageInstanceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.PersistentStorageInstance)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]v1alpha3.PersistentStorageInstance)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha2_PersistentStorageInstanceList is an autogenerated conversion function.
-func Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha2_PersistentStorageInstanceList(in *PersistentStorageInstanceList, out *v1alpha2.PersistentStorageInstanceList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_PersistentStorageInstanceList_To_v1alpha2_PersistentStorageInstanceList(in, out, s)
+// Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha3_PersistentStorageInstanceList is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentStorageInstanceList_To_v1alpha3_PersistentStorageInstanceList(in *PersistentStorageInstanceList, out *v1alpha3.PersistentStorageInstanceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentStorageInstanceList_To_v1alpha3_PersistentStorageInstanceList(in, out, s)
 }
 
-func autoConvert_v1alpha2_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in *v1alpha2.PersistentStorageInstanceList, out *PersistentStorageInstanceList, s conversion.Scope) error {
+func autoConvert_v1alpha3_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in *v1alpha3.PersistentStorageInstanceList, out *PersistentStorageInstanceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]PersistentStorageInstance)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha2_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList is an autogenerated conversion function.
-func Convert_v1alpha2_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in *v1alpha2.PersistentStorageInstanceList, out *PersistentStorageInstanceList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in, out, s)
+// Convert_v1alpha3_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList is an autogenerated conversion function.
+func Convert_v1alpha3_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in *v1alpha3.PersistentStorageInstanceList, out *PersistentStorageInstanceList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PersistentStorageInstanceList_To_v1alpha1_PersistentStorageInstanceList(in, out, s)
 }
 
-func autoConvert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec(in *PersistentStorageInstanceSpec, out *v1alpha2.PersistentStorageInstanceSpec, s conversion.Scope) error {
+func autoConvert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec(in *PersistentStorageInstanceSpec, out *v1alpha3.PersistentStorageInstanceSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.FsType = in.FsType
 	out.DWDirective = in.DWDirective
 	out.UserID = in.UserID
-	out.State = v1alpha2.PersistentStorageInstanceState(in.State)
+	out.State = v1alpha3.PersistentStorageInstanceState(in.State)
 	out.ConsumerReferences = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.ConsumerReferences))
 	return nil
 }
 
-// Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec is an autogenerated conversion function.
-func Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec(in *PersistentStorageInstanceSpec, out *v1alpha2.PersistentStorageInstanceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha2_PersistentStorageInstanceSpec(in, out, s)
+// Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec(in *PersistentStorageInstanceSpec, out *v1alpha3.PersistentStorageInstanceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentStorageInstanceSpec_To_v1alpha3_PersistentStorageInstanceSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in *v1alpha2.PersistentStorageInstanceSpec, out *PersistentStorageInstanceSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in *v1alpha3.PersistentStorageInstanceSpec, out *PersistentStorageInstanceSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.FsType = in.FsType
 	out.DWDirective = in.DWDirective
@@ -1393,396 +1516,412 @@ func autoConvert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentSt
 	return nil
 }
 
-// Convert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec is an autogenerated conversion function.
-func Convert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in *v1alpha2.PersistentStorageInstanceSpec, out *PersistentStorageInstanceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in, out, s)
+// Convert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec is an autogenerated conversion function.
+func Convert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in *v1alpha3.PersistentStorageInstanceSpec, out *PersistentStorageInstanceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PersistentStorageInstanceSpec_To_v1alpha1_PersistentStorageInstanceSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus(in *PersistentStorageInstanceStatus, out *v1alpha2.PersistentStorageInstanceStatus, s conversion.Scope) error {
+func autoConvert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus(in *PersistentStorageInstanceStatus, out *v1alpha3.PersistentStorageInstanceStatus, s conversion.Scope) error {
 	out.Servers = in.Servers
-	out.State = v1alpha2.PersistentStorageInstanceState(in.State)
-	if err := Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+	out.State = v1alpha3.PersistentStorageInstanceState(in.State)
+	if err := Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus is an autogenerated conversion function.
-func Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus(in *PersistentStorageInstanceStatus, out *v1alpha2.PersistentStorageInstanceStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha2_PersistentStorageInstanceStatus(in, out, s)
+// Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus(in *PersistentStorageInstanceStatus, out *v1alpha3.PersistentStorageInstanceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PersistentStorageInstanceStatus_To_v1alpha3_PersistentStorageInstanceStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in *v1alpha2.PersistentStorageInstanceStatus, out *PersistentStorageInstanceStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in *v1alpha3.PersistentStorageInstanceStatus, out *PersistentStorageInstanceStatus, s conversion.Scope) error {
 	out.Servers = in.Servers
 	out.State = PersistentStorageInstanceState(in.State)
-	if err := Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
+	if err := Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(&in.ResourceError, &out.ResourceError, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus is an autogenerated conversion function.
-func Convert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in *v1alpha2.PersistentStorageInstanceStatus, out *PersistentStorageInstanceStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in, out, s)
+// Convert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus is an autogenerated conversion function.
+func Convert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in *v1alpha3.PersistentStorageInstanceStatus, out *PersistentStorageInstanceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PersistentStorageInstanceStatus_To_v1alpha1_PersistentStorageInstanceStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(in *ResourceError, out *v1alpha2.ResourceError, s conversion.Scope) error {
-	out.Error = (*v1alpha2.ResourceErrorInfo)(unsafe.Pointer(in.Error))
+func autoConvert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(in *ResourceError, out *v1alpha3.ResourceError, s conversion.Scope) error {
+	out.Error = (*v1alpha3.ResourceErrorInfo)(unsafe.Pointer(in.Error))
 	return nil
 }
 
-// Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError is an autogenerated conversion function.
-func Convert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(in *ResourceError, out *v1alpha2.ResourceError, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ResourceError_To_v1alpha2_ResourceError(in, out, s)
+// Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(in *ResourceError, out *v1alpha3.ResourceError, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceError_To_v1alpha3_ResourceError(in, out, s)
 }
 
-func autoConvert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(in *v1alpha2.ResourceError, out *ResourceError, s conversion.Scope) error {
+func autoConvert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(in *v1alpha3.ResourceError, out *ResourceError, s conversion.Scope) error {
 	out.Error = (*ResourceErrorInfo)(unsafe.Pointer(in.Error))
 	return nil
 }
 
-// Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError is an autogenerated conversion function.
-func Convert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(in *v1alpha2.ResourceError, out *ResourceError, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ResourceError_To_v1alpha1_ResourceError(in, out, s)
+// Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError is an autogenerated conversion function.
+func Convert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(in *v1alpha3.ResourceError, out *ResourceError, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ResourceError_To_v1alpha1_ResourceError(in, out, s)
 }
 
-func autoConvert_v1alpha1_ResourceErrorInfo_To_v1alpha2_ResourceErrorInfo(in *ResourceErrorInfo, out *v1alpha2.ResourceErrorInfo, s conversion.Scope) error {
+func autoConvert_v1alpha1_ResourceErrorInfo_To_v1alpha3_ResourceErrorInfo(in *ResourceErrorInfo, out *v1alpha3.ResourceErrorInfo, s conversion.Scope) error {
 	out.UserMessage = in.UserMessage
 	out.DebugMessage = in.DebugMessage
 	out.Recoverable = in.Recoverable
 	return nil
 }
 
-// Convert_v1alpha1_ResourceErrorInfo_To_v1alpha2_ResourceErrorInfo is an autogenerated conversion function.
-func Convert_v1alpha1_ResourceErrorInfo_To_v1alpha2_ResourceErrorInfo(in *ResourceErrorInfo, out *v1alpha2.ResourceErrorInfo, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ResourceErrorInfo_To_v1alpha2_ResourceErrorInfo(in, out, s)
+// Convert_v1alpha1_ResourceErrorInfo_To_v1alpha3_ResourceErrorInfo is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceErrorInfo_To_v1alpha3_ResourceErrorInfo(in *ResourceErrorInfo, out *v1alpha3.ResourceErrorInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceErrorInfo_To_v1alpha3_ResourceErrorInfo(in, out, s)
 }
 
-func autoConvert_v1alpha2_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in *v1alpha2.ResourceErrorInfo, out *ResourceErrorInfo, s conversion.Scope) error {
+func autoConvert_v1alpha3_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in *v1alpha3.ResourceErrorInfo, out *ResourceErrorInfo, s conversion.Scope) error {
 	out.UserMessage = in.UserMessage
 	out.DebugMessage = in.DebugMessage
 	out.Recoverable = in.Recoverable
 	return nil
 }
 
-// Convert_v1alpha2_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo is an autogenerated conversion function.
-func Convert_v1alpha2_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in *v1alpha2.ResourceErrorInfo, out *ResourceErrorInfo, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in, out, s)
+// Convert_v1alpha3_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo is an autogenerated conversion function.
+func Convert_v1alpha3_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in *v1alpha3.ResourceErrorInfo, out *ResourceErrorInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ResourceErrorInfo_To_v1alpha1_ResourceErrorInfo(in, out, s)
 }
 
-func autoConvert_v1alpha1_Servers_To_v1alpha2_Servers(in *Servers, out *v1alpha2.Servers, s conversion.Scope) error {
+func autoConvert_v1alpha1_Servers_To_v1alpha3_Servers(in *Servers, out *v1alpha3.Servers, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_Servers_To_v1alpha2_Servers is an autogenerated conversion function.
-func Convert_v1alpha1_Servers_To_v1alpha2_Servers(in *Servers, out *v1alpha2.Servers, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Servers_To_v1alpha2_Servers(in, out, s)
+// Convert_v1alpha1_Servers_To_v1alpha3_Servers is an autogenerated conversion function.
+func Convert_v1alpha1_Servers_To_v1alpha3_Servers(in *Servers, out *v1alpha3.Servers, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Servers_To_v1alpha3_Servers(in, out, s)
 }
 
-func autoConvert_v1alpha2_Servers_To_v1alpha1_Servers(in *v1alpha2.Servers, out *Servers, s conversion.Scope) error {
+func autoConvert_v1alpha3_Servers_To_v1alpha1_Servers(in *v1alpha3.Servers, out *Servers, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_ServersStatus_To_v1alpha1_ServersStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_Servers_To_v1alpha1_Servers is an autogenerated conversion function.
-func Convert_v1alpha2_Servers_To_v1alpha1_Servers(in *v1alpha2.Servers, out *Servers, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Servers_To_v1alpha1_Servers(in, out, s)
+// Convert_v1alpha3_Servers_To_v1alpha1_Servers is an autogenerated conversion function.
+func Convert_v1alpha3_Servers_To_v1alpha1_Servers(in *v1alpha3.Servers, out *Servers, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Servers_To_v1alpha1_Servers(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersList_To_v1alpha2_ServersList(in *ServersList, out *v1alpha2.ServersList, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersList_To_v1alpha3_ServersList(in *ServersList, out *v1alpha3.ServersList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.Servers)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha3.Servers, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Servers_To_v1alpha3_Servers(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_ServersList_To_v1alpha2_ServersList is an autogenerated conversion function.
-func Convert_v1alpha1_ServersList_To_v1alpha2_ServersList(in *ServersList, out *v1alpha2.ServersList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersList_To_v1alpha2_ServersList(in, out, s)
+// Convert_v1alpha1_ServersList_To_v1alpha3_ServersList is an autogenerated conversion function.
+func Convert_v1alpha1_ServersList_To_v1alpha3_ServersList(in *ServersList, out *v1alpha3.ServersList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersList_To_v1alpha3_ServersList(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersList_To_v1alpha1_ServersList(in *v1alpha2.ServersList, out *ServersList, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersList_To_v1alpha1_ServersList(in *v1alpha3.ServersList, out *ServersList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Servers)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Servers, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_Servers_To_v1alpha1_Servers(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha2_ServersList_To_v1alpha1_ServersList is an autogenerated conversion function.
-func Convert_v1alpha2_ServersList_To_v1alpha1_ServersList(in *v1alpha2.ServersList, out *ServersList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersList_To_v1alpha1_ServersList(in, out, s)
+// Convert_v1alpha3_ServersList_To_v1alpha1_ServersList is an autogenerated conversion function.
+func Convert_v1alpha3_ServersList_To_v1alpha1_ServersList(in *v1alpha3.ServersList, out *ServersList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersList_To_v1alpha1_ServersList(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec(in *ServersSpec, out *v1alpha2.ServersSpec, s conversion.Scope) error {
-	out.AllocationSets = *(*[]v1alpha2.ServersSpecAllocationSet)(unsafe.Pointer(&in.AllocationSets))
+func autoConvert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec(in *ServersSpec, out *v1alpha3.ServersSpec, s conversion.Scope) error {
+	out.AllocationSets = *(*[]v1alpha3.ServersSpecAllocationSet)(unsafe.Pointer(&in.AllocationSets))
 	return nil
 }
 
-// Convert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec is an autogenerated conversion function.
-func Convert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec(in *ServersSpec, out *v1alpha2.ServersSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersSpec_To_v1alpha2_ServersSpec(in, out, s)
+// Convert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec(in *ServersSpec, out *v1alpha3.ServersSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersSpec_To_v1alpha3_ServersSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec(in *v1alpha2.ServersSpec, out *ServersSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec(in *v1alpha3.ServersSpec, out *ServersSpec, s conversion.Scope) error {
 	out.AllocationSets = *(*[]ServersSpecAllocationSet)(unsafe.Pointer(&in.AllocationSets))
 	return nil
 }
 
-// Convert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec is an autogenerated conversion function.
-func Convert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec(in *v1alpha2.ServersSpec, out *ServersSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersSpec_To_v1alpha1_ServersSpec(in, out, s)
+// Convert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec is an autogenerated conversion function.
+func Convert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec(in *v1alpha3.ServersSpec, out *ServersSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersSpec_To_v1alpha1_ServersSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersSpecAllocationSet_To_v1alpha2_ServersSpecAllocationSet(in *ServersSpecAllocationSet, out *v1alpha2.ServersSpecAllocationSet, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersSpecAllocationSet_To_v1alpha3_ServersSpecAllocationSet(in *ServersSpecAllocationSet, out *v1alpha3.ServersSpecAllocationSet, s conversion.Scope) error {
 	out.Label = in.Label
 	out.AllocationSize = in.AllocationSize
-	out.Storage = *(*[]v1alpha2.ServersSpecStorage)(unsafe.Pointer(&in.Storage))
+	out.Storage = *(*[]v1alpha3.ServersSpecStorage)(unsafe.Pointer(&in.Storage))
 	return nil
 }
 
-// Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha2_ServersSpecAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha2_ServersSpecAllocationSet(in *ServersSpecAllocationSet, out *v1alpha2.ServersSpecAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersSpecAllocationSet_To_v1alpha2_ServersSpecAllocationSet(in, out, s)
+// Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha3_ServersSpecAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha1_ServersSpecAllocationSet_To_v1alpha3_ServersSpecAllocationSet(in *ServersSpecAllocationSet, out *v1alpha3.ServersSpecAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersSpecAllocationSet_To_v1alpha3_ServersSpecAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in *v1alpha2.ServersSpecAllocationSet, out *ServersSpecAllocationSet, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in *v1alpha3.ServersSpecAllocationSet, out *ServersSpecAllocationSet, s conversion.Scope) error {
 	out.Label = in.Label
 	out.AllocationSize = in.AllocationSize
 	out.Storage = *(*[]ServersSpecStorage)(unsafe.Pointer(&in.Storage))
 	return nil
 }
 
-// Convert_v1alpha2_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha2_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in *v1alpha2.ServersSpecAllocationSet, out *ServersSpecAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in, out, s)
+// Convert_v1alpha3_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha3_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in *v1alpha3.ServersSpecAllocationSet, out *ServersSpecAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersSpecAllocationSet_To_v1alpha1_ServersSpecAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersSpecStorage_To_v1alpha2_ServersSpecStorage(in *ServersSpecStorage, out *v1alpha2.ServersSpecStorage, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersSpecStorage_To_v1alpha3_ServersSpecStorage(in *ServersSpecStorage, out *v1alpha3.ServersSpecStorage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AllocationCount = in.AllocationCount
 	return nil
 }
 
-// Convert_v1alpha1_ServersSpecStorage_To_v1alpha2_ServersSpecStorage is an autogenerated conversion function.
-func Convert_v1alpha1_ServersSpecStorage_To_v1alpha2_ServersSpecStorage(in *ServersSpecStorage, out *v1alpha2.ServersSpecStorage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersSpecStorage_To_v1alpha2_ServersSpecStorage(in, out, s)
+// Convert_v1alpha1_ServersSpecStorage_To_v1alpha3_ServersSpecStorage is an autogenerated conversion function.
+func Convert_v1alpha1_ServersSpecStorage_To_v1alpha3_ServersSpecStorage(in *ServersSpecStorage, out *v1alpha3.ServersSpecStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersSpecStorage_To_v1alpha3_ServersSpecStorage(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in *v1alpha2.ServersSpecStorage, out *ServersSpecStorage, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in *v1alpha3.ServersSpecStorage, out *ServersSpecStorage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AllocationCount = in.AllocationCount
 	return nil
 }
 
-// Convert_v1alpha2_ServersSpecStorage_To_v1alpha1_ServersSpecStorage is an autogenerated conversion function.
-func Convert_v1alpha2_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in *v1alpha2.ServersSpecStorage, out *ServersSpecStorage, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in, out, s)
+// Convert_v1alpha3_ServersSpecStorage_To_v1alpha1_ServersSpecStorage is an autogenerated conversion function.
+func Convert_v1alpha3_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in *v1alpha3.ServersSpecStorage, out *ServersSpecStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersSpecStorage_To_v1alpha1_ServersSpecStorage(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus(in *ServersStatus, out *v1alpha2.ServersStatus, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus(in *ServersStatus, out *v1alpha3.ServersStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.LastUpdate = (*metav1.MicroTime)(unsafe.Pointer(in.LastUpdate))
-	out.AllocationSets = *(*[]v1alpha2.ServersStatusAllocationSet)(unsafe.Pointer(&in.AllocationSets))
+	out.AllocationSets = *(*[]v1alpha3.ServersStatusAllocationSet)(unsafe.Pointer(&in.AllocationSets))
 	return nil
 }
 
-// Convert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus is an autogenerated conversion function.
-func Convert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus(in *ServersStatus, out *v1alpha2.ServersStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersStatus_To_v1alpha2_ServersStatus(in, out, s)
+// Convert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus(in *ServersStatus, out *v1alpha3.ServersStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersStatus_To_v1alpha3_ServersStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus(in *v1alpha2.ServersStatus, out *ServersStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersStatus_To_v1alpha1_ServersStatus(in *v1alpha3.ServersStatus, out *ServersStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.LastUpdate = (*metav1.MicroTime)(unsafe.Pointer(in.LastUpdate))
 	out.AllocationSets = *(*[]ServersStatusAllocationSet)(unsafe.Pointer(&in.AllocationSets))
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus is an autogenerated conversion function.
-func Convert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus(in *v1alpha2.ServersStatus, out *ServersStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersStatus_To_v1alpha1_ServersStatus(in, out, s)
-}
-
-func autoConvert_v1alpha1_ServersStatusAllocationSet_To_v1alpha2_ServersStatusAllocationSet(in *ServersStatusAllocationSet, out *v1alpha2.ServersStatusAllocationSet, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersStatusAllocationSet_To_v1alpha3_ServersStatusAllocationSet(in *ServersStatusAllocationSet, out *v1alpha3.ServersStatusAllocationSet, s conversion.Scope) error {
 	out.Label = in.Label
-	out.Storage = *(*map[string]v1alpha2.ServersStatusStorage)(unsafe.Pointer(&in.Storage))
+	out.Storage = *(*map[string]v1alpha3.ServersStatusStorage)(unsafe.Pointer(&in.Storage))
 	return nil
 }
 
-// Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha2_ServersStatusAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha2_ServersStatusAllocationSet(in *ServersStatusAllocationSet, out *v1alpha2.ServersStatusAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersStatusAllocationSet_To_v1alpha2_ServersStatusAllocationSet(in, out, s)
+// Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha3_ServersStatusAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha1_ServersStatusAllocationSet_To_v1alpha3_ServersStatusAllocationSet(in *ServersStatusAllocationSet, out *v1alpha3.ServersStatusAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersStatusAllocationSet_To_v1alpha3_ServersStatusAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in *v1alpha2.ServersStatusAllocationSet, out *ServersStatusAllocationSet, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in *v1alpha3.ServersStatusAllocationSet, out *ServersStatusAllocationSet, s conversion.Scope) error {
 	out.Label = in.Label
 	out.Storage = *(*map[string]ServersStatusStorage)(unsafe.Pointer(&in.Storage))
 	return nil
 }
 
-// Convert_v1alpha2_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha2_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in *v1alpha2.ServersStatusAllocationSet, out *ServersStatusAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in, out, s)
+// Convert_v1alpha3_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha3_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in *v1alpha3.ServersStatusAllocationSet, out *ServersStatusAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersStatusAllocationSet_To_v1alpha1_ServersStatusAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha1_ServersStatusStorage_To_v1alpha2_ServersStatusStorage(in *ServersStatusStorage, out *v1alpha2.ServersStatusStorage, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServersStatusStorage_To_v1alpha3_ServersStatusStorage(in *ServersStatusStorage, out *v1alpha3.ServersStatusStorage, s conversion.Scope) error {
 	out.AllocationSize = in.AllocationSize
 	return nil
 }
 
-// Convert_v1alpha1_ServersStatusStorage_To_v1alpha2_ServersStatusStorage is an autogenerated conversion function.
-func Convert_v1alpha1_ServersStatusStorage_To_v1alpha2_ServersStatusStorage(in *ServersStatusStorage, out *v1alpha2.ServersStatusStorage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ServersStatusStorage_To_v1alpha2_ServersStatusStorage(in, out, s)
+// Convert_v1alpha1_ServersStatusStorage_To_v1alpha3_ServersStatusStorage is an autogenerated conversion function.
+func Convert_v1alpha1_ServersStatusStorage_To_v1alpha3_ServersStatusStorage(in *ServersStatusStorage, out *v1alpha3.ServersStatusStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServersStatusStorage_To_v1alpha3_ServersStatusStorage(in, out, s)
 }
 
-func autoConvert_v1alpha2_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in *v1alpha2.ServersStatusStorage, out *ServersStatusStorage, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in *v1alpha3.ServersStatusStorage, out *ServersStatusStorage, s conversion.Scope) error {
 	out.AllocationSize = in.AllocationSize
 	return nil
 }
 
-// Convert_v1alpha2_ServersStatusStorage_To_v1alpha1_ServersStatusStorage is an autogenerated conversion function.
-func Convert_v1alpha2_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in *v1alpha2.ServersStatusStorage, out *ServersStatusStorage, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in, out, s)
+// Convert_v1alpha3_ServersStatusStorage_To_v1alpha1_ServersStatusStorage is an autogenerated conversion function.
+func Convert_v1alpha3_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in *v1alpha3.ServersStatusStorage, out *ServersStatusStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServersStatusStorage_To_v1alpha1_ServersStatusStorage(in, out, s)
 }
 
-func autoConvert_v1alpha1_Storage_To_v1alpha2_Storage(in *Storage, out *v1alpha2.Storage, s conversion.Scope) error {
+func autoConvert_v1alpha1_Storage_To_v1alpha3_Storage(in *Storage, out *v1alpha3.Storage, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_Storage_To_v1alpha2_Storage is an autogenerated conversion function.
-func Convert_v1alpha1_Storage_To_v1alpha2_Storage(in *Storage, out *v1alpha2.Storage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Storage_To_v1alpha2_Storage(in, out, s)
+// Convert_v1alpha1_Storage_To_v1alpha3_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_v1alpha3_Storage(in *Storage, out *v1alpha3.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_v1alpha3_Storage(in, out, s)
 }
 
-func autoConvert_v1alpha2_Storage_To_v1alpha1_Storage(in *v1alpha2.Storage, out *Storage, s conversion.Scope) error {
+func autoConvert_v1alpha3_Storage_To_v1alpha1_Storage(in *v1alpha3.Storage, out *Storage, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
-func Convert_v1alpha2_Storage_To_v1alpha1_Storage(in *v1alpha2.Storage, out *Storage, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Storage_To_v1alpha1_Storage(in, out, s)
+// Convert_v1alpha3_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_v1alpha3_Storage_To_v1alpha1_Storage(in *v1alpha3.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess(in *StorageAccess, out *v1alpha2.StorageAccess, s conversion.Scope) error {
-	out.Protocol = v1alpha2.StorageAccessProtocol(in.Protocol)
-	out.Servers = *(*[]v1alpha2.Node)(unsafe.Pointer(&in.Servers))
-	out.Computes = *(*[]v1alpha2.Node)(unsafe.Pointer(&in.Computes))
+func autoConvert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess(in *StorageAccess, out *v1alpha3.StorageAccess, s conversion.Scope) error {
+	out.Protocol = v1alpha3.StorageAccessProtocol(in.Protocol)
+	out.Servers = *(*[]v1alpha3.Node)(unsafe.Pointer(&in.Servers))
+	out.Computes = *(*[]v1alpha3.Node)(unsafe.Pointer(&in.Computes))
 	return nil
 }
 
-// Convert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess is an autogenerated conversion function.
-func Convert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess(in *StorageAccess, out *v1alpha2.StorageAccess, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess(in, out, s)
+// Convert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess(in *StorageAccess, out *v1alpha3.StorageAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess(in *v1alpha2.StorageAccess, out *StorageAccess, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess(in *v1alpha3.StorageAccess, out *StorageAccess, s conversion.Scope) error {
 	out.Protocol = StorageAccessProtocol(in.Protocol)
 	out.Servers = *(*[]Node)(unsafe.Pointer(&in.Servers))
 	out.Computes = *(*[]Node)(unsafe.Pointer(&in.Computes))
 	return nil
 }
 
-// Convert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess is an autogenerated conversion function.
-func Convert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess(in *v1alpha2.StorageAccess, out *StorageAccess, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess(in, out, s)
+// Convert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess is an autogenerated conversion function.
+func Convert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess(in *v1alpha3.StorageAccess, out *StorageAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageAllocationSet_To_v1alpha2_StorageAllocationSet(in *StorageAllocationSet, out *v1alpha2.StorageAllocationSet, s conversion.Scope) error {
-	out.AllocationStrategy = v1alpha2.AllocationStrategy(in.AllocationStrategy)
+func autoConvert_v1alpha1_StorageAllocationSet_To_v1alpha3_StorageAllocationSet(in *StorageAllocationSet, out *v1alpha3.StorageAllocationSet, s conversion.Scope) error {
+	out.AllocationStrategy = v1alpha3.AllocationStrategy(in.AllocationStrategy)
 	out.MinimumCapacity = in.MinimumCapacity
 	out.Label = in.Label
-	if err := Convert_v1alpha1_AllocationSetConstraints_To_v1alpha2_AllocationSetConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+	if err := Convert_v1alpha1_AllocationSetConstraints_To_v1alpha3_AllocationSetConstraints(&in.Constraints, &out.Constraints, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_StorageAllocationSet_To_v1alpha2_StorageAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha1_StorageAllocationSet_To_v1alpha2_StorageAllocationSet(in *StorageAllocationSet, out *v1alpha2.StorageAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageAllocationSet_To_v1alpha2_StorageAllocationSet(in, out, s)
+// Convert_v1alpha1_StorageAllocationSet_To_v1alpha3_StorageAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAllocationSet_To_v1alpha3_StorageAllocationSet(in *StorageAllocationSet, out *v1alpha3.StorageAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAllocationSet_To_v1alpha3_StorageAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in *v1alpha2.StorageAllocationSet, out *StorageAllocationSet, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in *v1alpha3.StorageAllocationSet, out *StorageAllocationSet, s conversion.Scope) error {
 	out.AllocationStrategy = AllocationStrategy(in.AllocationStrategy)
 	out.MinimumCapacity = in.MinimumCapacity
 	out.Label = in.Label
-	if err := Convert_v1alpha2_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+	if err := Convert_v1alpha3_AllocationSetConstraints_To_v1alpha1_AllocationSetConstraints(&in.Constraints, &out.Constraints, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_StorageAllocationSet_To_v1alpha1_StorageAllocationSet is an autogenerated conversion function.
-func Convert_v1alpha2_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in *v1alpha2.StorageAllocationSet, out *StorageAllocationSet, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in, out, s)
+// Convert_v1alpha3_StorageAllocationSet_To_v1alpha1_StorageAllocationSet is an autogenerated conversion function.
+func Convert_v1alpha3_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in *v1alpha3.StorageAllocationSet, out *StorageAllocationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageAllocationSet_To_v1alpha1_StorageAllocationSet(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageBreakdown_To_v1alpha2_StorageBreakdown(in *StorageBreakdown, out *v1alpha2.StorageBreakdown, s conversion.Scope) error {
+func autoConvert_v1alpha1_StorageBreakdown_To_v1alpha3_StorageBreakdown(in *StorageBreakdown, out *v1alpha3.StorageBreakdown, s conversion.Scope) error {
 	out.Lifetime = in.Lifetime
 	out.Reference = in.Reference
-	out.AllocationSets = *(*[]v1alpha2.StorageAllocationSet)(unsafe.Pointer(&in.AllocationSets))
+	out.AllocationSets = *(*[]v1alpha3.StorageAllocationSet)(unsafe.Pointer(&in.AllocationSets))
 	return nil
 }
 
-// Convert_v1alpha1_StorageBreakdown_To_v1alpha2_StorageBreakdown is an autogenerated conversion function.
-func Convert_v1alpha1_StorageBreakdown_To_v1alpha2_StorageBreakdown(in *StorageBreakdown, out *v1alpha2.StorageBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageBreakdown_To_v1alpha2_StorageBreakdown(in, out, s)
+// Convert_v1alpha1_StorageBreakdown_To_v1alpha3_StorageBreakdown is an autogenerated conversion function.
+func Convert_v1alpha1_StorageBreakdown_To_v1alpha3_StorageBreakdown(in *StorageBreakdown, out *v1alpha3.StorageBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageBreakdown_To_v1alpha3_StorageBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageBreakdown_To_v1alpha1_StorageBreakdown(in *v1alpha2.StorageBreakdown, out *StorageBreakdown, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageBreakdown_To_v1alpha1_StorageBreakdown(in *v1alpha3.StorageBreakdown, out *StorageBreakdown, s conversion.Scope) error {
 	out.Lifetime = in.Lifetime
 	out.Reference = in.Reference
 	out.AllocationSets = *(*[]StorageAllocationSet)(unsafe.Pointer(&in.AllocationSets))
 	return nil
 }
 
-// Convert_v1alpha2_StorageBreakdown_To_v1alpha1_StorageBreakdown is an autogenerated conversion function.
-func Convert_v1alpha2_StorageBreakdown_To_v1alpha1_StorageBreakdown(in *v1alpha2.StorageBreakdown, out *StorageBreakdown, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageBreakdown_To_v1alpha1_StorageBreakdown(in, out, s)
+// Convert_v1alpha3_StorageBreakdown_To_v1alpha1_StorageBreakdown is an autogenerated conversion function.
+func Convert_v1alpha3_StorageBreakdown_To_v1alpha1_StorageBreakdown(in *v1alpha3.StorageBreakdown, out *StorageBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageBreakdown_To_v1alpha1_StorageBreakdown(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageDevice_To_v1alpha2_StorageDevice(in *StorageDevice, out *v1alpha2.StorageDevice, s conversion.Scope) error {
+func autoConvert_v1alpha1_StorageDevice_To_v1alpha3_StorageDevice(in *StorageDevice, out *v1alpha3.StorageDevice, s conversion.Scope) error {
 	out.Model = in.Model
 	out.SerialNumber = in.SerialNumber
 	out.FirmwareVersion = in.FirmwareVersion
 	out.Slot = in.Slot
 	out.Capacity = in.Capacity
 	out.WearLevel = (*int64)(unsafe.Pointer(in.WearLevel))
-	out.Status = v1alpha2.ResourceStatus(in.Status)
+	out.Status = v1alpha3.ResourceStatus(in.Status)
 	return nil
 }
 
-// Convert_v1alpha1_StorageDevice_To_v1alpha2_StorageDevice is an autogenerated conversion function.
-func Convert_v1alpha1_StorageDevice_To_v1alpha2_StorageDevice(in *StorageDevice, out *v1alpha2.StorageDevice, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageDevice_To_v1alpha2_StorageDevice(in, out, s)
+// Convert_v1alpha1_StorageDevice_To_v1alpha3_StorageDevice is an autogenerated conversion function.
+func Convert_v1alpha1_StorageDevice_To_v1alpha3_StorageDevice(in *StorageDevice, out *v1alpha3.StorageDevice, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageDevice_To_v1alpha3_StorageDevice(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice(in *v1alpha2.StorageDevice, out *StorageDevice, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageDevice_To_v1alpha1_StorageDevice(in *v1alpha3.StorageDevice, out *StorageDevice, s conversion.Scope) error {
 	out.Model = in.Model
 	out.SerialNumber = in.SerialNumber
 	out.FirmwareVersion = in.FirmwareVersion
@@ -1793,75 +1932,75 @@ func autoConvert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice(in *v1alpha2.S
 	return nil
 }
 
-// Convert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice is an autogenerated conversion function.
-func Convert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice(in *v1alpha2.StorageDevice, out *StorageDevice, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageDevice_To_v1alpha1_StorageDevice(in, out, s)
+// Convert_v1alpha3_StorageDevice_To_v1alpha1_StorageDevice is an autogenerated conversion function.
+func Convert_v1alpha3_StorageDevice_To_v1alpha1_StorageDevice(in *v1alpha3.StorageDevice, out *StorageDevice, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageDevice_To_v1alpha1_StorageDevice(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageList_To_v1alpha2_StorageList(in *StorageList, out *v1alpha2.StorageList, s conversion.Scope) error {
+func autoConvert_v1alpha1_StorageList_To_v1alpha3_StorageList(in *StorageList, out *v1alpha3.StorageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.Storage)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]v1alpha3.Storage)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha1_StorageList_To_v1alpha2_StorageList is an autogenerated conversion function.
-func Convert_v1alpha1_StorageList_To_v1alpha2_StorageList(in *StorageList, out *v1alpha2.StorageList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageList_To_v1alpha2_StorageList(in, out, s)
+// Convert_v1alpha1_StorageList_To_v1alpha3_StorageList is an autogenerated conversion function.
+func Convert_v1alpha1_StorageList_To_v1alpha3_StorageList(in *StorageList, out *v1alpha3.StorageList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageList_To_v1alpha3_StorageList(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageList_To_v1alpha1_StorageList(in *v1alpha2.StorageList, out *StorageList, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageList_To_v1alpha1_StorageList(in *v1alpha3.StorageList, out *StorageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]Storage)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha2_StorageList_To_v1alpha1_StorageList is an autogenerated conversion function.
-func Convert_v1alpha2_StorageList_To_v1alpha1_StorageList(in *v1alpha2.StorageList, out *StorageList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageList_To_v1alpha1_StorageList(in, out, s)
+// Convert_v1alpha3_StorageList_To_v1alpha1_StorageList is an autogenerated conversion function.
+func Convert_v1alpha3_StorageList_To_v1alpha1_StorageList(in *v1alpha3.StorageList, out *StorageList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageList_To_v1alpha1_StorageList(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec(in *StorageSpec, out *v1alpha2.StorageSpec, s conversion.Scope) error {
-	out.State = v1alpha2.ResourceState(in.State)
+func autoConvert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec(in *StorageSpec, out *v1alpha3.StorageSpec, s conversion.Scope) error {
+	out.State = v1alpha3.ResourceState(in.State)
 	return nil
 }
 
-// Convert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec is an autogenerated conversion function.
-func Convert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec(in *StorageSpec, out *v1alpha2.StorageSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageSpec_To_v1alpha2_StorageSpec(in, out, s)
+// Convert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec is an autogenerated conversion function.
+func Convert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec(in *StorageSpec, out *v1alpha3.StorageSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageSpec_To_v1alpha3_StorageSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec(in *v1alpha2.StorageSpec, out *StorageSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec(in *v1alpha3.StorageSpec, out *StorageSpec, s conversion.Scope) error {
 	out.State = ResourceState(in.State)
 	return nil
 }
 
-// Convert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec is an autogenerated conversion function.
-func Convert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec(in *v1alpha2.StorageSpec, out *StorageSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageSpec_To_v1alpha1_StorageSpec(in, out, s)
+// Convert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec is an autogenerated conversion function.
+func Convert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec(in *v1alpha3.StorageSpec, out *StorageSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageSpec_To_v1alpha1_StorageSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus(in *StorageStatus, out *v1alpha2.StorageStatus, s conversion.Scope) error {
-	out.Type = v1alpha2.StorageType(in.Type)
-	out.Devices = *(*[]v1alpha2.StorageDevice)(unsafe.Pointer(&in.Devices))
-	if err := Convert_v1alpha1_StorageAccess_To_v1alpha2_StorageAccess(&in.Access, &out.Access, s); err != nil {
+func autoConvert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus(in *StorageStatus, out *v1alpha3.StorageStatus, s conversion.Scope) error {
+	out.Type = v1alpha3.StorageType(in.Type)
+	out.Devices = *(*[]v1alpha3.StorageDevice)(unsafe.Pointer(&in.Devices))
+	if err := Convert_v1alpha1_StorageAccess_To_v1alpha3_StorageAccess(&in.Access, &out.Access, s); err != nil {
 		return err
 	}
 	out.Capacity = in.Capacity
-	out.Status = v1alpha2.ResourceStatus(in.Status)
+	out.Status = v1alpha3.ResourceStatus(in.Status)
 	out.RebootRequired = in.RebootRequired
 	out.Message = in.Message
 	return nil
 }
 
-// Convert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus is an autogenerated conversion function.
-func Convert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus(in *StorageStatus, out *v1alpha2.StorageStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_StorageStatus_To_v1alpha2_StorageStatus(in, out, s)
+// Convert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus is an autogenerated conversion function.
+func Convert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus(in *StorageStatus, out *v1alpha3.StorageStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageStatus_To_v1alpha3_StorageStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(in *v1alpha2.StorageStatus, out *StorageStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus(in *v1alpha3.StorageStatus, out *StorageStatus, s conversion.Scope) error {
 	out.Type = StorageType(in.Type)
 	out.Devices = *(*[]StorageDevice)(unsafe.Pointer(&in.Devices))
-	if err := Convert_v1alpha2_StorageAccess_To_v1alpha1_StorageAccess(&in.Access, &out.Access, s); err != nil {
+	if err := Convert_v1alpha3_StorageAccess_To_v1alpha1_StorageAccess(&in.Access, &out.Access, s); err != nil {
 		return err
 	}
 	out.Capacity = in.Capacity
@@ -1871,252 +2010,248 @@ func autoConvert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(in *v1alpha2.S
 	return nil
 }
 
-// Convert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus is an autogenerated conversion function.
-func Convert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(in *v1alpha2.StorageStatus, out *StorageStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StorageStatus_To_v1alpha1_StorageStatus(in, out, s)
+// Convert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus is an autogenerated conversion function.
+func Convert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus(in *v1alpha3.StorageStatus, out *StorageStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_StorageStatus_To_v1alpha1_StorageStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfiguration_To_v1alpha2_SystemConfiguration(in *SystemConfiguration, out *v1alpha2.SystemConfiguration, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfiguration_To_v1alpha3_SystemConfiguration(in *SystemConfiguration, out *v1alpha3.SystemConfiguration, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfiguration_To_v1alpha2_SystemConfiguration is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfiguration_To_v1alpha2_SystemConfiguration(in *SystemConfiguration, out *v1alpha2.SystemConfiguration, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfiguration_To_v1alpha2_SystemConfiguration(in, out, s)
+// Convert_v1alpha1_SystemConfiguration_To_v1alpha3_SystemConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfiguration_To_v1alpha3_SystemConfiguration(in *SystemConfiguration, out *v1alpha3.SystemConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfiguration_To_v1alpha3_SystemConfiguration(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfiguration_To_v1alpha1_SystemConfiguration(in *v1alpha2.SystemConfiguration, out *SystemConfiguration, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfiguration_To_v1alpha1_SystemConfiguration(in *v1alpha3.SystemConfiguration, out *SystemConfiguration, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfiguration_To_v1alpha1_SystemConfiguration is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfiguration_To_v1alpha1_SystemConfiguration(in *v1alpha2.SystemConfiguration, out *SystemConfiguration, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfiguration_To_v1alpha1_SystemConfiguration(in, out, s)
+// Convert_v1alpha3_SystemConfiguration_To_v1alpha1_SystemConfiguration is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfiguration_To_v1alpha1_SystemConfiguration(in *v1alpha3.SystemConfiguration, out *SystemConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfiguration_To_v1alpha1_SystemConfiguration(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha2_SystemConfigurationComputeNode(in *SystemConfigurationComputeNode, out *v1alpha2.SystemConfigurationComputeNode, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha3_SystemConfigurationComputeNode(in *SystemConfigurationComputeNode, out *v1alpha3.SystemConfigurationComputeNode, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha2_SystemConfigurationComputeNode is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha2_SystemConfigurationComputeNode(in *SystemConfigurationComputeNode, out *v1alpha2.SystemConfigurationComputeNode, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha2_SystemConfigurationComputeNode(in, out, s)
+// Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha3_SystemConfigurationComputeNode is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha3_SystemConfigurationComputeNode(in *SystemConfigurationComputeNode, out *v1alpha3.SystemConfigurationComputeNode, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationComputeNode_To_v1alpha3_SystemConfigurationComputeNode(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in *v1alpha2.SystemConfigurationComputeNode, out *SystemConfigurationComputeNode, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in *v1alpha3.SystemConfigurationComputeNode, out *SystemConfigurationComputeNode, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in *v1alpha2.SystemConfigurationComputeNode, out *SystemConfigurationComputeNode, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in, out, s)
+// Convert_v1alpha3_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in *v1alpha3.SystemConfigurationComputeNode, out *SystemConfigurationComputeNode, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationComputeNode_To_v1alpha1_SystemConfigurationComputeNode(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha2_SystemConfigurationComputeNodeReference(in *SystemConfigurationComputeNodeReference, out *v1alpha2.SystemConfigurationComputeNodeReference, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha3_SystemConfigurationComputeNodeReference(in *SystemConfigurationComputeNodeReference, out *v1alpha3.SystemConfigurationComputeNodeReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Index = in.Index
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha2_SystemConfigurationComputeNodeReference is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha2_SystemConfigurationComputeNodeReference(in *SystemConfigurationComputeNodeReference, out *v1alpha2.SystemConfigurationComputeNodeReference, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha2_SystemConfigurationComputeNodeReference(in, out, s)
+// Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha3_SystemConfigurationComputeNodeReference is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha3_SystemConfigurationComputeNodeReference(in *SystemConfigurationComputeNodeReference, out *v1alpha3.SystemConfigurationComputeNodeReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationComputeNodeReference_To_v1alpha3_SystemConfigurationComputeNodeReference(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in *v1alpha2.SystemConfigurationComputeNodeReference, out *SystemConfigurationComputeNodeReference, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in *v1alpha3.SystemConfigurationComputeNodeReference, out *SystemConfigurationComputeNodeReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Index = in.Index
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in *v1alpha2.SystemConfigurationComputeNodeReference, out *SystemConfigurationComputeNodeReference, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in, out, s)
+// Convert_v1alpha3_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in *v1alpha3.SystemConfigurationComputeNodeReference, out *SystemConfigurationComputeNodeReference, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationComputeNodeReference_To_v1alpha1_SystemConfigurationComputeNodeReference(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationList_To_v1alpha2_SystemConfigurationList(in *SystemConfigurationList, out *v1alpha2.SystemConfigurationList, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfigurationList_To_v1alpha3_SystemConfigurationList(in *SystemConfigurationList, out *v1alpha3.SystemConfigurationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.SystemConfiguration)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]v1alpha3.SystemConfiguration)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationList_To_v1alpha2_SystemConfigurationList is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationList_To_v1alpha2_SystemConfigurationList(in *SystemConfigurationList, out *v1alpha2.SystemConfigurationList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationList_To_v1alpha2_SystemConfigurationList(in, out, s)
+// Convert_v1alpha1_SystemConfigurationList_To_v1alpha3_SystemConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationList_To_v1alpha3_SystemConfigurationList(in *SystemConfigurationList, out *v1alpha3.SystemConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationList_To_v1alpha3_SystemConfigurationList(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in *v1alpha2.SystemConfigurationList, out *SystemConfigurationList, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in *v1alpha3.SystemConfigurationList, out *SystemConfigurationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]SystemConfiguration)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationList_To_v1alpha1_SystemConfigurationList is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in *v1alpha2.SystemConfigurationList, out *SystemConfigurationList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in, out, s)
+// Convert_v1alpha3_SystemConfigurationList_To_v1alpha1_SystemConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in *v1alpha3.SystemConfigurationList, out *SystemConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationList_To_v1alpha1_SystemConfigurationList(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec(in *SystemConfigurationSpec, out *v1alpha2.SystemConfigurationSpec, s conversion.Scope) error {
-	out.ComputeNodes = *(*[]v1alpha2.SystemConfigurationComputeNode)(unsafe.Pointer(&in.ComputeNodes))
-	out.StorageNodes = *(*[]v1alpha2.SystemConfigurationStorageNode)(unsafe.Pointer(&in.StorageNodes))
+func autoConvert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec(in *SystemConfigurationSpec, out *v1alpha3.SystemConfigurationSpec, s conversion.Scope) error {
+	out.ComputeNodes = *(*[]v1alpha3.SystemConfigurationComputeNode)(unsafe.Pointer(&in.ComputeNodes))
+	out.StorageNodes = *(*[]v1alpha3.SystemConfigurationStorageNode)(unsafe.Pointer(&in.StorageNodes))
 	out.Ports = *(*[]intstr.IntOrString)(unsafe.Pointer(&in.Ports))
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec(in *SystemConfigurationSpec, out *v1alpha2.SystemConfigurationSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationSpec_To_v1alpha2_SystemConfigurationSpec(in, out, s)
+// Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec(in *SystemConfigurationSpec, out *v1alpha3.SystemConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationSpec_To_v1alpha3_SystemConfigurationSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in *v1alpha2.SystemConfigurationSpec, out *SystemConfigurationSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in *v1alpha3.SystemConfigurationSpec, out *SystemConfigurationSpec, s conversion.Scope) error {
 	out.ComputeNodes = *(*[]SystemConfigurationComputeNode)(unsafe.Pointer(&in.ComputeNodes))
 	out.StorageNodes = *(*[]SystemConfigurationStorageNode)(unsafe.Pointer(&in.StorageNodes))
 	out.Ports = *(*[]intstr.IntOrString)(unsafe.Pointer(&in.Ports))
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in *v1alpha2.SystemConfigurationSpec, out *SystemConfigurationSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in, out, s)
+// Convert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in *v1alpha3.SystemConfigurationSpec, out *SystemConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationSpec_To_v1alpha1_SystemConfigurationSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus(in *SystemConfigurationStatus, out *v1alpha2.SystemConfigurationStatus, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus(in *SystemConfigurationStatus, out *v1alpha3.SystemConfigurationStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus(in *SystemConfigurationStatus, out *v1alpha2.SystemConfigurationStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationStatus_To_v1alpha2_SystemConfigurationStatus(in, out, s)
+// Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus(in *SystemConfigurationStatus, out *v1alpha3.SystemConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationStatus_To_v1alpha3_SystemConfigurationStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in *v1alpha2.SystemConfigurationStatus, out *SystemConfigurationStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in *v1alpha3.SystemConfigurationStatus, out *SystemConfigurationStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in *v1alpha2.SystemConfigurationStatus, out *SystemConfigurationStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in, out, s)
+// Convert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in *v1alpha3.SystemConfigurationStatus, out *SystemConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationStatus_To_v1alpha1_SystemConfigurationStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha2_SystemConfigurationStorageNode(in *SystemConfigurationStorageNode, out *v1alpha2.SystemConfigurationStorageNode, s conversion.Scope) error {
+func autoConvert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha3_SystemConfigurationStorageNode(in *SystemConfigurationStorageNode, out *v1alpha3.SystemConfigurationStorageNode, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Name = in.Name
-	out.ComputesAccess = *(*[]v1alpha2.SystemConfigurationComputeNodeReference)(unsafe.Pointer(&in.ComputesAccess))
+	out.ComputesAccess = *(*[]v1alpha3.SystemConfigurationComputeNodeReference)(unsafe.Pointer(&in.ComputesAccess))
 	return nil
 }
 
-// Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha2_SystemConfigurationStorageNode is an autogenerated conversion function.
-func Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha2_SystemConfigurationStorageNode(in *SystemConfigurationStorageNode, out *v1alpha2.SystemConfigurationStorageNode, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha2_SystemConfigurationStorageNode(in, out, s)
+// Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha3_SystemConfigurationStorageNode is an autogenerated conversion function.
+func Convert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha3_SystemConfigurationStorageNode(in *SystemConfigurationStorageNode, out *v1alpha3.SystemConfigurationStorageNode, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SystemConfigurationStorageNode_To_v1alpha3_SystemConfigurationStorageNode(in, out, s)
 }
 
-func autoConvert_v1alpha2_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in *v1alpha2.SystemConfigurationStorageNode, out *SystemConfigurationStorageNode, s conversion.Scope) error {
+func autoConvert_v1alpha3_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in *v1alpha3.SystemConfigurationStorageNode, out *SystemConfigurationStorageNode, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Name = in.Name
 	out.ComputesAccess = *(*[]SystemConfigurationComputeNodeReference)(unsafe.Pointer(&in.ComputesAccess))
 	return nil
 }
 
-// Convert_v1alpha2_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode is an autogenerated conversion function.
-func Convert_v1alpha2_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in *v1alpha2.SystemConfigurationStorageNode, out *SystemConfigurationStorageNode, s conversion.Scope) error {
-	return autoConvert_v1alpha2_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in, out, s)
+// Convert_v1alpha3_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode is an autogenerated conversion function.
+func Convert_v1alpha3_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in *v1alpha3.SystemConfigurationStorageNode, out *SystemConfigurationStorageNode, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SystemConfigurationStorageNode_To_v1alpha1_SystemConfigurationStorageNode(in, out, s)
 }
 
-func autoConvert_v1alpha1_Workflow_To_v1alpha2_Workflow(in *Workflow, out *v1alpha2.Workflow, s conversion.Scope) error {
+func autoConvert_v1alpha1_Workflow_To_v1alpha3_Workflow(in *Workflow, out *v1alpha3.Workflow, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha1_WorkflowSpec_To_v1alpha2_WorkflowSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha1_WorkflowSpec_To_v1alpha3_WorkflowSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_Workflow_To_v1alpha2_Workflow is an autogenerated conversion function.
-func Convert_v1alpha1_Workflow_To_v1alpha2_Workflow(in *Workflow, out *v1alpha2.Workflow, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Workflow_To_v1alpha2_Workflow(in, out, s)
+// Convert_v1alpha1_Workflow_To_v1alpha3_Workflow is an autogenerated conversion function.
+func Convert_v1alpha1_Workflow_To_v1alpha3_Workflow(in *Workflow, out *v1alpha3.Workflow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Workflow_To_v1alpha3_Workflow(in, out, s)
 }
 
-func autoConvert_v1alpha2_Workflow_To_v1alpha1_Workflow(in *v1alpha2.Workflow, out *Workflow, s conversion.Scope) error {
+func autoConvert_v1alpha3_Workflow_To_v1alpha1_Workflow(in *v1alpha3.Workflow, out *Workflow, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha2_WorkflowSpec_To_v1alpha1_WorkflowSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_WorkflowSpec_To_v1alpha1_WorkflowSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_WorkflowStatus_To_v1alpha1_WorkflowStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha2_Workflow_To_v1alpha1_Workflow is an autogenerated conversion function.
-func Convert_v1alpha2_Workflow_To_v1alpha1_Workflow(in *v1alpha2.Workflow, out *Workflow, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Workflow_To_v1alpha1_Workflow(in, out, s)
+// Convert_v1alpha3_Workflow_To_v1alpha1_Workflow is an autogenerated conversion function.
+func Convert_v1alpha3_Workflow_To_v1alpha1_Workflow(in *v1alpha3.Workflow, out *Workflow, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Workflow_To_v1alpha1_Workflow(in, out, s)
 }
 
-func autoConvert_v1alpha1_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(in *WorkflowDriverStatus, out *v1alpha2.WorkflowDriverStatus, s conversion.Scope) error {
+func autoConvert_v1alpha1_WorkflowDriverStatus_To_v1alpha3_WorkflowDriverStatus(in *WorkflowDriverStatus, out *v1alpha3.WorkflowDriverStatus, s conversion.Scope) error {
 	out.DriverID = in.DriverID
 	out.TaskID = in.TaskID
 	out.DWDIndex = in.DWDIndex
-	out.WatchState = v1alpha2.WorkflowState(in.WatchState)
-	out.LastHB = in.LastHB
+	out.WatchState = v1alpha3.WorkflowState(in.WatchState)
+	// WARNING: in.LastHB requires manual conversion: inconvertible types (int64 vs *k8s.io/apimachinery/pkg/apis/meta/v1.Time)
 	out.Completed = in.Completed
 	out.Status = in.Status
 	out.Message = in.Message
-	out.Error = in.Error
+	// WARNING: in.Error requires manual conversion: inconvertible types (string vs *github.com/HewlettPackard/dws/api/v1alpha3.ResourceErrorInfo)
 	out.CompleteTime = (*metav1.MicroTime)(unsafe.Pointer(in.CompleteTime))
 	return nil
 }
 
-// Convert_v1alpha1_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus is an autogenerated conversion function.
-func Convert_v1alpha1_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(in *WorkflowDriverStatus, out *v1alpha2.WorkflowDriverStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(in, out, s)
-}
-
-func autoConvert_v1alpha2_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(in *v1alpha2.WorkflowDriverStatus, out *WorkflowDriverStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(in *v1alpha3.WorkflowDriverStatus, out *WorkflowDriverStatus, s conversion.Scope) error {
 	out.DriverID = in.DriverID
 	out.TaskID = in.TaskID
 	out.DWDIndex = in.DWDIndex
 	out.WatchState = WorkflowState(in.WatchState)
-	out.LastHB = in.LastHB
+	// WARNING: in.LastHB requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Time vs int64)
 	out.Completed = in.Completed
+	// WARNING: in.Unresponsive requires manual conversion: does not exist in peer-type
 	out.Status = in.Status
 	out.Message = in.Message
-	out.Error = in.Error
+	// WARNING: in.Error requires manual conversion: inconvertible types (*github.com/HewlettPackard/dws/api/v1alpha3.ResourceErrorInfo vs string)
 	out.CompleteTime = (*metav1.MicroTime)(unsafe.Pointer(in.CompleteTime))
+	// WARNING: in.CancelTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.ErrorTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.Priority requires manual conversion: does not exist in peer-type
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
+	// WARNING: in.Eligible requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus is an autogenerated conversion function.
-func Convert_v1alpha2_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(in *v1alpha2.WorkflowDriverStatus, out *WorkflowDriverStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(in, out, s)
-}
-
-func autoConvert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList(in *WorkflowList, out *v1alpha2.WorkflowList, s conversion.Scope) error {
+func autoConvert_v1alpha1_WorkflowList_To_v1alpha3_WorkflowList(in *WorkflowList, out *v1alpha3.WorkflowList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1alpha2.Workflow, len(*in))
+		*out = make([]v1alpha3.Workflow, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha1_Workflow_To_v1alpha2_Workflow(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha1_Workflow_To_v1alpha3_Workflow(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -2126,18 +2261,18 @@ func autoConvert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList(in *WorkflowList
 	return nil
 }
 
-// Convert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList is an autogenerated conversion function.
-func Convert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList(in *WorkflowList, out *v1alpha2.WorkflowList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_WorkflowList_To_v1alpha2_WorkflowList(in, out, s)
+// Convert_v1alpha1_WorkflowList_To_v1alpha3_WorkflowList is an autogenerated conversion function.
+func Convert_v1alpha1_WorkflowList_To_v1alpha3_WorkflowList(in *WorkflowList, out *v1alpha3.WorkflowList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkflowList_To_v1alpha3_WorkflowList(in, out, s)
 }
 
-func autoConvert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList(in *v1alpha2.WorkflowList, out *WorkflowList, s conversion.Scope) error {
+func autoConvert_v1alpha3_WorkflowList_To_v1alpha1_WorkflowList(in *v1alpha3.WorkflowList, out *WorkflowList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Workflow, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha2_Workflow_To_v1alpha1_Workflow(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha3_Workflow_To_v1alpha1_Workflow(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -2147,13 +2282,13 @@ func autoConvert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList(in *v1alpha2.Wor
 	return nil
 }
 
-// Convert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList is an autogenerated conversion function.
-func Convert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList(in *v1alpha2.WorkflowList, out *WorkflowList, s conversion.Scope) error {
-	return autoConvert_v1alpha2_WorkflowList_To_v1alpha1_WorkflowList(in, out, s)
+// Convert_v1alpha3_WorkflowList_To_v1alpha1_WorkflowList is an autogenerated conversion function.
+func Convert_v1alpha3_WorkflowList_To_v1alpha1_WorkflowList(in *v1alpha3.WorkflowList, out *WorkflowList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_WorkflowList_To_v1alpha1_WorkflowList(in, out, s)
 }
 
-func autoConvert_v1alpha1_WorkflowSpec_To_v1alpha2_WorkflowSpec(in *WorkflowSpec, out *v1alpha2.WorkflowSpec, s conversion.Scope) error {
-	out.DesiredState = v1alpha2.WorkflowState(in.DesiredState)
+func autoConvert_v1alpha1_WorkflowSpec_To_v1alpha3_WorkflowSpec(in *WorkflowSpec, out *v1alpha3.WorkflowSpec, s conversion.Scope) error {
+	out.DesiredState = v1alpha3.WorkflowState(in.DesiredState)
 	out.WLMID = in.WLMID
 	// WARNING: in.JobID requires manual conversion: inconvertible types (int vs k8s.io/apimachinery/pkg/util/intstr.IntOrString)
 	out.UserID = in.UserID
@@ -2163,7 +2298,7 @@ func autoConvert_v1alpha1_WorkflowSpec_To_v1alpha2_WorkflowSpec(in *WorkflowSpec
 	return nil
 }
 
-func autoConvert_v1alpha2_WorkflowSpec_To_v1alpha1_WorkflowSpec(in *v1alpha2.WorkflowSpec, out *WorkflowSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_WorkflowSpec_To_v1alpha1_WorkflowSpec(in *v1alpha3.WorkflowSpec, out *WorkflowSpec, s conversion.Scope) error {
 	out.DesiredState = WorkflowState(in.DesiredState)
 	out.WLMID = in.WLMID
 	// WARNING: in.JobID requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs int)
@@ -2171,16 +2306,33 @@ func autoConvert_v1alpha2_WorkflowSpec_To_v1alpha1_WorkflowSpec(in *v1alpha2.Wor
 	out.GroupID = in.GroupID
 	out.Hurry = in.Hurry
 	out.DWDirectives = *(*[]string)(unsafe.Pointer(&in.DWDirectives))
+	// WARNING: in.StateTimeouts requires manual conversion: does not exist in peer-type
+	// WARNING: in.TimeoutPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.RetryGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoAdvance requires manual conversion: does not exist in peer-type
+	// WARNING: in.Suspend requires manual conversion: does not exist in peer-type
+	// WARNING: in.TTLSecondsAfterFinished requires manual conversion: does not exist in peer-type
+	// WARNING: in.Predecessor requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-func autoConvert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(in *WorkflowStatus, out *v1alpha2.WorkflowStatus, s conversion.Scope) error {
-	out.State = v1alpha2.WorkflowState(in.State)
+func autoConvert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus(in *WorkflowStatus, out *v1alpha3.WorkflowStatus, s conversion.Scope) error {
+	out.State = v1alpha3.WorkflowState(in.State)
 	out.Ready = in.Ready
 	out.Status = in.Status
 	out.Message = in.Message
 	out.Env = *(*map[string]string)(unsafe.Pointer(&in.Env))
-	out.Drivers = *(*[]v1alpha2.WorkflowDriverStatus)(unsafe.Pointer(&in.Drivers))
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]v1alpha3.WorkflowDriverStatus, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_WorkflowDriverStatus_To_v1alpha3_WorkflowDriverStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Drivers = nil
+	}
 	out.DirectiveBreakdowns = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.DirectiveBreakdowns))
 	out.Computes = in.Computes
 	out.DesiredStateChange = (*metav1.MicroTime)(unsafe.Pointer(in.DesiredStateChange))
@@ -2189,27 +2341,42 @@ func autoConvert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(in *Workflow
 	return nil
 }
 
-// Convert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus is an autogenerated conversion function.
-func Convert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(in *WorkflowStatus, out *v1alpha2.WorkflowStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_WorkflowStatus_To_v1alpha2_WorkflowStatus(in, out, s)
+// Convert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus(in *WorkflowStatus, out *v1alpha3.WorkflowStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkflowStatus_To_v1alpha3_WorkflowStatus(in, out, s)
 }
 
-func autoConvert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus(in *v1alpha2.WorkflowStatus, out *WorkflowStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_WorkflowStatus_To_v1alpha1_WorkflowStatus(in *v1alpha3.WorkflowStatus, out *WorkflowStatus, s conversion.Scope) error {
 	out.State = WorkflowState(in.State)
 	out.Ready = in.Ready
 	out.Status = in.Status
+	// WARNING: in.ResourceError requires manual conversion: does not exist in peer-type
 	out.Message = in.Message
+	// WARNING: in.DirectiveSummaries requires manual conversion: does not exist in peer-type
 	out.Env = *(*map[string]string)(unsafe.Pointer(&in.Env))
-	out.Drivers = *(*[]WorkflowDriverStatus)(unsafe.Pointer(&in.Drivers))
+	// WARNING: in.EnvVars requires manual conversion: does not exist in peer-type
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]WorkflowDriverStatus, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_WorkflowDriverStatus_To_v1alpha1_WorkflowDriverStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Drivers = nil
+	}
 	out.DirectiveBreakdowns = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.DirectiveBreakdowns))
 	out.Computes = in.Computes
 	out.DesiredStateChange = (*metav1.MicroTime)(unsafe.Pointer(in.DesiredStateChange))
 	out.ReadyChange = (*metav1.MicroTime)(unsafe.Pointer(in.ReadyChange))
 	out.ElapsedTimeLastState = in.ElapsedTimeLastState
+	// WARNING: in.ObservedRetryGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.RetryChange requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuspendChange requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuspendedTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.StateHistory requires manual conversion: does not exist in peer-type
+	// WARNING: in.Handoffs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
-
-// Convert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus is an autogenerated conversion function.
-func Convert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus(in *v1alpha2.WorkflowStatus, out *WorkflowStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_WorkflowStatus_To_v1alpha1_WorkflowStatus(in, out, s)
-}
@@ -187,7 +187,6 @@ type ClientMountStatus struct {
 }
 
 //+kubebuilder:object:root=true
-//+kubebuilder:storageversion
 //+kubebuilder:subresource:status
 
 // ClientMount is the Schema for the clientmounts API
@@ -19,23 +19,609 @@
 
 package v1alpha2
 
-func (*ClientMount) Hub()               {}
-func (*Computes) Hub()                  {}
-func (*DWDirectiveRule) Hub()           {}
-func (*DirectiveBreakdown) Hub()        {}
-func (*PersistentStorageInstance) Hub() {}
-func (*Servers) Hub()                   {}
-func (*Storage) Hub()                   {}
-func (*SystemConfiguration) Hub()       {}
-func (*Workflow) Hub()                  {}
-
-// The conversion-verifier tool wants these...though they're never used.
-func (*ClientMountList) Hub()               {}
-func (*ComputesList) Hub()                  {}
-func (*DWDirectiveRuleList) Hub()           {}
-func (*DirectiveBreakdownList) Hub()        {}
-func (*PersistentStorageInstanceList) Hub() {}
-func (*ServersList) Hub()                   {}
-func (*StorageList) Hub()                   {}
-func (*SystemConfigurationList) Hub()       {}
-func (*WorkflowList) Hub()                  {}
+import (
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	utilconversion "github.com/HewlettPackard/dws/github/cluster-api/util/conversion"
+)
+
+var convertlog = logf.Log.V(2).WithName("convert-v1alpha2")
+
+func (src *ClientMount) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert ClientMount To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.ClientMount)
+
+	if err := Convert_v1alpha2_ClientMount_To_v1alpha3_ClientMount(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.ClientMount{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	dst.Status.Conditions = restored.Status.Conditions
+
+	// Restore the device reference data unless the spoke changed it
+	if len(dst.Spec.Mounts) == len(restored.Spec.Mounts) {
+		for i := range dst.Spec.Mounts {
+			ref := dst.Spec.Mounts[i].Device.DeviceReference
+			restoredRef := restored.Spec.Mounts[i].Device.DeviceReference
+			if ref != nil && restoredRef != nil && src.Spec.Mounts[i].Device.DeviceReference.Data == deviceReferenceData(restoredRef.Data) {
+				ref.Data = restoredRef.Data
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dst *ClientMount) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.ClientMount)
+	convertlog.Info("Convert ClientMount From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_ClientMount_To_v1alpha2_ClientMount(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *Computes) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert Computes To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.Computes)
+
+	if err := Convert_v1alpha2_Computes_To_v1alpha3_Computes(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.Computes{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	return nil
+}
+
+func (dst *Computes) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.Computes)
+	convertlog.Info("Convert Computes From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_Computes_To_v1alpha2_Computes(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *DWDirectiveRule) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert DWDirectiveRule To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.DWDirectiveRule)
+
+	if err := Convert_v1alpha2_DWDirectiveRule_To_v1alpha3_DWDirectiveRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.DWDirectiveRule{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	// Restore the driver ordering, which the spoke doesn't hold, and the watch states
+	// unless the spoke changed them
+	if len(dst.Spec) == len(restored.Spec) {
+		for i := range dst.Spec {
+			dst.Spec[i].Priority = restored.Spec[i].Priority
+			dst.Spec[i].DependsOn = restored.Spec[i].DependsOn
+
+			if src.Spec[i].WatchStates == restored.Spec[i].ParserSpec().WatchStates {
+				dst.Spec[i].WatchStates = restored.Spec[i].WatchStates
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dst *DWDirectiveRule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.DWDirectiveRule)
+	convertlog.Info("Convert DWDirectiveRule From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_DWDirectiveRule_To_v1alpha2_DWDirectiveRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *DirectiveBreakdown) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert DirectiveBreakdown To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.DirectiveBreakdown)
+
+	if err := Convert_v1alpha2_DirectiveBreakdown_To_v1alpha3_DirectiveBreakdown(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.DirectiveBreakdown{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	dst.Status.Conditions = restored.Status.Conditions
+
+	return nil
+}
+
+func (dst *DirectiveBreakdown) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.DirectiveBreakdown)
+	convertlog.Info("Convert DirectiveBreakdown From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_DirectiveBreakdown_To_v1alpha2_DirectiveBreakdown(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *PersistentStorageInstance) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert PersistentStorageInstance To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.PersistentStorageInstance)
+
+	if err := Convert_v1alpha2_PersistentStorageInstance_To_v1alpha3_PersistentStorageInstance(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.PersistentStorageInstance{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	return nil
+}
+
+func (dst *PersistentStorageInstance) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.PersistentStorageInstance)
+	convertlog.Info("Convert PersistentStorageInstance From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_PersistentStorageInstance_To_v1alpha2_PersistentStorageInstance(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *Servers) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert Servers To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.Servers)
+
+	if err := Convert_v1alpha2_Servers_To_v1alpha3_Servers(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.Servers{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	dst.Status.Conditions = restored.Status.Conditions
+
+	return nil
+}
+
+func (dst *Servers) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.Servers)
+	convertlog.Info("Convert Servers From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_Servers_To_v1alpha2_Servers(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *Storage) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert Storage To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.Storage)
+
+	if err := Convert_v1alpha2_Storage_To_v1alpha3_Storage(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.Storage{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	return nil
+}
+
+func (dst *Storage) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.Storage)
+	convertlog.Info("Convert Storage From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_Storage_To_v1alpha2_Storage(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *SystemConfiguration) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert SystemConfiguration To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.SystemConfiguration)
+
+	if err := Convert_v1alpha2_SystemConfiguration_To_v1alpha3_SystemConfiguration(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.SystemConfiguration{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	return nil
+}
+
+func (dst *SystemConfiguration) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.SystemConfiguration)
+	convertlog.Info("Convert SystemConfiguration From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_SystemConfiguration_To_v1alpha2_SystemConfiguration(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func (src *Workflow) ConvertTo(dstRaw conversion.Hub) error {
+	convertlog.Info("Convert Workflow To Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+	dst := dstRaw.(*dwsv1alpha3.Workflow)
+
+	if err := Convert_v1alpha2_Workflow_To_v1alpha3_Workflow(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &dwsv1alpha3.Workflow{}
+	hasAnno, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	// EDIT THIS FUNCTION! If the annotation is holding anything that is
+	// hub-specific then copy it into 'dst' from 'restored'.
+	// Otherwise, you may comment out UnmarshalData() until it's needed.
+
+	if hasAnno {
+		dst.Status.Conditions = restored.Status.Conditions
+		dst.Spec.RetryGeneration = restored.Spec.RetryGeneration
+		dst.Spec.AutoAdvance = restored.Spec.AutoAdvance
+		dst.Spec.Suspend = restored.Spec.Suspend
+		dst.Spec.TTLSecondsAfterFinished = restored.Spec.TTLSecondsAfterFinished
+		dst.Spec.Predecessor = restored.Spec.Predecessor
+		dst.Status.ObservedRetryGeneration = restored.Status.ObservedRetryGeneration
+		dst.Status.RetryChange = restored.Status.RetryChange
+		dst.Status.SuspendChange = restored.Status.SuspendChange
+		dst.Status.SuspendedTime = restored.Status.SuspendedTime
+		dst.Status.ResourceError = restored.Status.ResourceError
+		dst.Status.DirectiveSummaries = restored.Status.DirectiveSummaries
+		dst.Status.Handoffs = restored.Status.Handoffs
+
+		// The spoke reports a recoverable error as Error, so only restore the status if the
+		// spoke hasn't changed it
+		if src.Status.Status == workflowStatus(restored.Status.Status) {
+			dst.Status.Status = restored.Status.Status
+		}
+
+		// Restore the driver fields that the spoke doesn't hold or only holds as a string
+		if len(dst.Status.Drivers) == len(restored.Status.Drivers) {
+			for i := range dst.Status.Drivers {
+				// The spoke holds the error as a string, the heartbeat in seconds, and a
+				// cancelled entry as Pending, so only restore them if the spoke hasn't changed them
+				if src.Status.Drivers[i].Status == driverStatus(restored.Status.Drivers[i].Status) {
+					dst.Status.Drivers[i].Status = restored.Status.Drivers[i].Status
+				}
+				if src.Status.Drivers[i].Error == driverErrorMessage(restored.Status.Drivers[i].Error) {
+					dst.Status.Drivers[i].Error = restored.Status.Drivers[i].Error
+				}
+				if src.Status.Drivers[i].LastHB == driverHeartbeat(restored.Status.Drivers[i].LastHB) {
+					dst.Status.Drivers[i].LastHB = restored.Status.Drivers[i].LastHB
+				}
+				dst.Status.Drivers[i].Priority = restored.Status.Drivers[i].Priority
+				dst.Status.Drivers[i].DependsOn = restored.Status.Drivers[i].DependsOn
+				dst.Status.Drivers[i].Eligible = restored.Status.Drivers[i].Eligible
+				dst.Status.Drivers[i].CancelTime = restored.Status.Drivers[i].CancelTime
+				dst.Status.Drivers[i].ErrorTime = restored.Status.Drivers[i].ErrorTime
+				dst.Status.Drivers[i].Unresponsive = restored.Status.Drivers[i].Unresponsive
+			}
+		}
+	}
+
+	// The spoke only holds the Env view of the environment variables. Apply its changes to the
+	// variables restored from the annotation, which fails if it changed another driver's variable.
+	dst.Status.EnvVars = restored.Status.EnvVars
+	if err := dst.Status.MergeEnv(src.Status.Env); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dst *Workflow) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*dwsv1alpha3.Workflow)
+	convertlog.Info("Convert Workflow From Hub", "name", src.GetName(), "namespace", src.GetNamespace())
+
+	if err := Convert_v1alpha3_Workflow_To_v1alpha2_Workflow(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+// The List-based ConvertTo/ConvertFrom routines are never used by the
+// conversion webhook, but the conversion-verifier tool wants to see them.
+// The conversion-gen tool generated the Convert_X_to_Y routines, should they
+// ever be needed.
+
+func resource(resource string) schema.GroupResource {
+	return schema.GroupResource{Group: "dws", Resource: resource}
+}
+
+func (src *ClientMountList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ClientMountList"), "ConvertTo")
+}
+
+func (dst *ClientMountList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ClientMountList"), "ConvertFrom")
+}
+
+func (src *ComputesList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ComputesList"), "ConvertTo")
+}
+
+func (dst *ComputesList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ComputesList"), "ConvertFrom")
+}
+
+func (src *DWDirectiveRuleList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("DWDirectiveRuleList"), "ConvertTo")
+}
+
+func (dst *DWDirectiveRuleList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("DWDirectiveRuleList"), "ConvertFrom")
+}
+
+func (src *DirectiveBreakdownList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("DirectiveBreakdownList"), "ConvertTo")
+}
+
+func (dst *DirectiveBreakdownList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("DirectiveBreakdownList"), "ConvertFrom")
+}
+
+func (src *PersistentStorageInstanceList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("PersistentStorageInstanceList"), "ConvertTo")
+}
+
+func (dst *PersistentStorageInstanceList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("PersistentStorageInstanceList"), "ConvertFrom")
+}
+
+func (src *ServersList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ServersList"), "ConvertTo")
+}
+
+func (dst *ServersList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("ServersList"), "ConvertFrom")
+}
+
+func (src *StorageList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("StorageList"), "ConvertTo")
+}
+
+func (dst *StorageList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("StorageList"), "ConvertFrom")
+}
+
+func (src *SystemConfigurationList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("SystemConfigurationList"), "ConvertTo")
+}
+
+func (dst *SystemConfigurationList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("SystemConfigurationList"), "ConvertFrom")
+}
+
+func (src *WorkflowList) ConvertTo(dstRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("WorkflowList"), "ConvertTo")
+}
+
+func (dst *WorkflowList) ConvertFrom(srcRaw conversion.Hub) error {
+	return apierrors.NewMethodNotSupported(resource("WorkflowList"), "ConvertFrom")
+}
+
+// The conversion-gen tool dropped these from zz_generated.conversion.go to
+// force us to acknowledge that we are addressing the conversion requirements.
+
+func Convert_v1alpha3_ClientMountStatus_To_v1alpha2_ClientMountStatus(in *dwsv1alpha3.ClientMountStatus, out *ClientMountStatus, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_ClientMountStatus_To_v1alpha2_ClientMountStatus(in, out, s)
+}
+
+func Convert_v1alpha3_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(in *dwsv1alpha3.DirectiveBreakdownStatus, out *DirectiveBreakdownStatus, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_DirectiveBreakdownStatus_To_v1alpha2_DirectiveBreakdownStatus(in, out, s)
+}
+
+func Convert_v1alpha3_ServersStatus_To_v1alpha2_ServersStatus(in *dwsv1alpha3.ServersStatus, out *ServersStatus, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_ServersStatus_To_v1alpha2_ServersStatus(in, out, s)
+}
+
+func Convert_v1alpha3_WorkflowStatus_To_v1alpha2_WorkflowStatus(in *dwsv1alpha3.WorkflowStatus, out *WorkflowStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha3_WorkflowStatus_To_v1alpha2_WorkflowStatus(in, out, s); err != nil {
+		return err
+	}
+
+	out.Status = workflowStatus(in.Status)
+
+	return nil
+}
+
+func Convert_v1alpha3_WorkflowSpec_To_v1alpha2_WorkflowSpec(in *dwsv1alpha3.WorkflowSpec, out *WorkflowSpec, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_WorkflowSpec_To_v1alpha2_WorkflowSpec(in, out, s)
+}
+
+func Convert_v1alpha3_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(in *dwsv1alpha3.WorkflowDriverStatus, out *WorkflowDriverStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha3_WorkflowDriverStatus_To_v1alpha2_WorkflowDriverStatus(in, out, s); err != nil {
+		return err
+	}
+
+	out.Status = driverStatus(in.Status)
+	out.Error = driverErrorMessage(in.Error)
+	out.LastHB = driverHeartbeat(in.LastHB)
+
+	return nil
+}
+
+func Convert_v1alpha2_WorkflowDriverStatus_To_v1alpha3_WorkflowDriverStatus(in *WorkflowDriverStatus, out *dwsv1alpha3.WorkflowDriverStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_WorkflowDriverStatus_To_v1alpha3_WorkflowDriverStatus(in, out, s); err != nil {
+		return err
+	}
+
+	// Errors from older drivers carry no recoverable flag, so they are treated as fatal
+	if in.Error != "" {
+		out.Error = dwsv1alpha3.NewResourceError(in.Error, nil).WithFatal()
+	}
+
+	if in.LastHB != 0 {
+		lastHB := metav1.Unix(in.LastHB, 0)
+		out.LastHB = &lastHB
+	}
+
+	return nil
+}
+
+// driverErrorMessage returns the string form of a hub driver error
+func driverErrorMessage(err *dwsv1alpha3.ResourceErrorInfo) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// driverStatus returns the spoke form of a hub driver status. The spoke has no Cancelled
+// status, so a driver entry cancelled before it completed is reported as Pending.
+func driverStatus(status string) string {
+	if status == dwsv1alpha3.StatusCancelled {
+		return StatusPending
+	}
+
+	return status
+}
+
+// workflowStatus returns the spoke form of a hub workflow status. The spoke has no
+// TransientCondition status, so a recoverable error is reported as Error.
+func workflowStatus(status string) string {
+	if status == dwsv1alpha3.StatusTransientCondition {
+		return StatusError
+	}
+
+	return status
+}
+
+// driverHeartbeat returns a hub driver heartbeat in seconds since the Unix epoch
+func driverHeartbeat(lastHB *metav1.Time) int64 {
+	if lastHB == nil {
+		return 0
+	}
+
+	return lastHB.Unix()
+}
+
+// deviceReferenceData returns the spoke's integer form of the hub's device reference data.
+// Data that isn't an integer can't be represented in the spoke and is restored from the
+// annotation on up-conversion.
+func deviceReferenceData(data string) int {
+	i, _ := strconv.Atoi(data)
+	return i
+}
+
+func Convert_v1alpha2_Computes_To_v1alpha3_Computes(in *Computes, out *dwsv1alpha3.Computes, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_Computes_To_v1alpha3_Computes(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Data != nil {
+		out.Spec.Data = make([]dwsv1alpha3.ComputesData, len(in.Data))
+		for i := range in.Data {
+			if err := Convert_v1alpha2_ComputesData_To_v1alpha3_ComputesData(&in.Data[i], &out.Spec.Data[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func Convert_v1alpha3_Computes_To_v1alpha2_Computes(in *dwsv1alpha3.Computes, out *Computes, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha3_Computes_To_v1alpha2_Computes(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Spec.Data != nil {
+		out.Data = make([]ComputesData, len(in.Spec.Data))
+		for i := range in.Spec.Data {
+			if err := Convert_v1alpha3_ComputesData_To_v1alpha2_ComputesData(&in.Spec.Data[i], &out.Data[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
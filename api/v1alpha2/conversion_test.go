@@ -0,0 +1,189 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	utilconversion "github.com/HewlettPackard/dws/github/cluster-api/util/conversion"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+
+	t.Run("for ClientMount", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.ClientMount{},
+		Spoke: &ClientMount{},
+	}))
+
+	t.Run("for Computes", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.Computes{},
+		Spoke: &Computes{},
+	}))
+
+	t.Run("for DWDirectiveRule", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.DWDirectiveRule{},
+		Spoke: &DWDirectiveRule{},
+	}))
+
+	t.Run("for DirectiveBreakdown", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.DirectiveBreakdown{},
+		Spoke: &DirectiveBreakdown{},
+	}))
+
+	t.Run("for PersistentStorageInstance", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.PersistentStorageInstance{},
+		Spoke: &PersistentStorageInstance{},
+	}))
+
+	t.Run("for Servers", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.Servers{},
+		Spoke: &Servers{},
+	}))
+
+	t.Run("for Storage", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.Storage{},
+		Spoke: &Storage{},
+	}))
+
+	t.Run("for SystemConfiguration", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &dwsv1alpha3.SystemConfiguration{},
+		Spoke: &SystemConfiguration{},
+	}))
+
+	t.Run("for Workflow", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:         &dwsv1alpha3.Workflow{},
+		Spoke:       &Workflow{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{workflowFuzzerFuncs},
+	}))
+
+}
+
+func workflowFuzzerFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		// Each environment variable is set once, and the Env view matches EnvVars
+		func(in *dwsv1alpha3.WorkflowStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(in)
+
+			envVars := []dwsv1alpha3.WorkflowEnvVar{}
+			names := map[string]bool{}
+			for _, envVar := range in.EnvVars {
+				if !names[envVar.Name] {
+					names[envVar.Name] = true
+					envVars = append(envVars, envVar)
+				}
+			}
+
+			in.EnvVars = envVars
+			in.Env = in.EnvMap()
+		},
+	}
+}
+
+func TestWorkflowEnvConversion(t *testing.T) {
+	g := NewWithT(t)
+
+	hub := &dwsv1alpha3.Workflow{}
+	g.Expect(hub.Status.SetEnv("DW_WORKFLOW_NAME", "test", dwsv1alpha3.EnvSourceDWS, -1)).To(Succeed())
+	g.Expect(hub.Status.SetEnv("DW_JOB_LEGACY", "/mnt/a", dwsv1alpha3.EnvSourceEnv, -1)).To(Succeed())
+
+	spoke := &Workflow{}
+	g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+
+	// A variable set through the spoke's Env is added with the EnvSourceEnv source
+	// ConvertTo consumes the annotation, so each case converts its own copy of the spoke
+	changed := spoke.DeepCopy()
+	changed.Status.Env["DW_JOB_STRIPED"] = "/mnt/striped"
+	changed.Status.Env["DW_JOB_LEGACY"] = "/mnt/b"
+	converted := &dwsv1alpha3.Workflow{}
+	g.Expect(changed.ConvertTo(converted)).To(Succeed())
+	g.Expect(converted.Status.EnvVars).To(ConsistOf(
+		dwsv1alpha3.WorkflowEnvVar{Name: "DW_WORKFLOW_NAME", Value: "test", DriverID: dwsv1alpha3.EnvSourceDWS, DWDIndex: -1},
+		dwsv1alpha3.WorkflowEnvVar{Name: "DW_JOB_LEGACY", Value: "/mnt/b", DriverID: dwsv1alpha3.EnvSourceEnv, DWDIndex: -1},
+		dwsv1alpha3.WorkflowEnvVar{Name: "DW_JOB_STRIPED", Value: "/mnt/striped", DriverID: dwsv1alpha3.EnvSourceEnv, DWDIndex: -1},
+	))
+	g.Expect(converted.Status.Env).To(HaveKeyWithValue("DW_JOB_LEGACY", "/mnt/b"))
+
+	// A variable set by another driver can't be changed or removed through the spoke's Env
+	changed = spoke.DeepCopy()
+	changed.Status.Env["DW_WORKFLOW_NAME"] = "other"
+	g.Expect(changed.ConvertTo(&dwsv1alpha3.Workflow{})).ToNot(Succeed())
+
+	changed = spoke.DeepCopy()
+	delete(changed.Status.Env, "DW_WORKFLOW_NAME")
+	g.Expect(changed.ConvertTo(&dwsv1alpha3.Workflow{})).ToNot(Succeed())
+}
+
+func TestWorkflowStatusConversion(t *testing.T) {
+	g := NewWithT(t)
+
+	hub := &dwsv1alpha3.Workflow{}
+	hub.Status.Status = dwsv1alpha3.StatusTransientCondition
+
+	// The spoke reports a recoverable error as Error
+	spoke := &Workflow{}
+	g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+	g.Expect(spoke.Status.Status).To(Equal(StatusError))
+
+	converted := &dwsv1alpha3.Workflow{}
+	g.Expect(spoke.DeepCopy().ConvertTo(converted)).To(Succeed())
+	g.Expect(converted.Status.Status).To(Equal(dwsv1alpha3.StatusTransientCondition))
+
+	// A status changed in the spoke isn't replaced by the one in the annotation
+	changed := spoke.DeepCopy()
+	changed.Status.Status = StatusCompleted
+	converted = &dwsv1alpha3.Workflow{}
+	g.Expect(changed.ConvertTo(converted)).To(Succeed())
+	g.Expect(converted.Status.Status).To(Equal(dwsv1alpha3.StatusCompleted))
+}
+
+func TestWorkflowDriverStatusConversion(t *testing.T) {
+	g := NewWithT(t)
+
+	hub := &dwsv1alpha3.Workflow{}
+	hub.Status.Drivers = []dwsv1alpha3.WorkflowDriverStatus{
+		{DriverID: "cancelled", WatchState: dwsv1alpha3.StateDataIn, Status: dwsv1alpha3.StatusCancelled},
+		{DriverID: "running", WatchState: dwsv1alpha3.StateTeardown, Status: dwsv1alpha3.StatusRunning},
+	}
+
+	// The spoke reports a cancelled driver entry as Pending
+	spoke := &Workflow{}
+	g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+	g.Expect(spoke.Status.Drivers[0].Status).To(Equal(StatusPending))
+	g.Expect(spoke.Status.Drivers[1].Status).To(Equal(StatusRunning))
+
+	// A driver updating its own entry leaves the cancelled entry as it was
+	changed := spoke.DeepCopy()
+	changed.Status.Drivers[1].Status = StatusCompleted
+	converted := &dwsv1alpha3.Workflow{}
+	g.Expect(changed.ConvertTo(converted)).To(Succeed())
+	g.Expect(converted.Status.Drivers[0].Status).To(Equal(dwsv1alpha3.StatusCancelled))
+	g.Expect(converted.Status.Drivers[1].Status).To(Equal(dwsv1alpha3.StatusCompleted))
+}
+
+// Just touch ginkgo, so it's here to interpret any ginkgo args from
+// "make test", so that doesn't fail on this test file.
+var _ = BeforeSuite(func() {})
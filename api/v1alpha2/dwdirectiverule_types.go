@@ -27,7 +27,6 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 //+kubebuilder:object:root=true
-//+kubebuilder:storageversion
 
 // DWDirectiveRule is the Schema for the DWDirective API
 type DWDirectiveRule struct {
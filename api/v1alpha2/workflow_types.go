@@ -100,6 +100,20 @@ const (
 	StatusDriverWait = "DriverWait"
 )
 
+// WorkflowTimeoutPolicy is the enumeration of the actions taken when a state exceeds its timeout
+// +kubebuilder:validation:Enum:=Error;HurriedTeardown
+type WorkflowTimeoutPolicy string
+
+// WorkflowTimeoutPolicy values
+const (
+	// TimeoutPolicyError marks the workflow with Status=Error when a state times out
+	TimeoutPolicyError WorkflowTimeoutPolicy = "Error"
+
+	// TimeoutPolicyHurriedTeardown marks the workflow with Status=Error and moves the
+	// workflow to Teardown with the hurry flag set when a state times out
+	TimeoutPolicyHurriedTeardown WorkflowTimeoutPolicy = "HurriedTeardown"
+)
+
 // WorkflowSpec defines the desired state of Workflow
 type WorkflowSpec struct {
 	// Desired state for the workflow to be in. Unless progressing to the teardown state,
@@ -132,6 +146,15 @@ type WorkflowSpec struct {
 
 	// List of #DW strings from a WLM job script
 	DWDirectives []string `json:"dwDirectives"`
+
+	// StateTimeouts sets the maximum time the workflow may spend in a state before it is
+	// marked as an error. The time is measured from the most recent desiredState change.
+	// States without an entry have no timeout.
+	StateTimeouts map[WorkflowState]metav1.Duration `json:"stateTimeouts,omitempty"`
+
+	// TimeoutPolicy specifies the action taken when a state exceeds its timeout. An empty
+	// value is equivalent to Error.
+	TimeoutPolicy WorkflowTimeoutPolicy `json:"timeoutPolicy,omitempty"`
 }
 
 // WorkflowDriverStatus defines the status information provided by integration drivers.
@@ -142,6 +165,8 @@ type WorkflowDriverStatus struct {
 
 	WatchState WorkflowState `json:"watchState"`
 
+	// LastHB is the time of the driver's most recent heartbeat in seconds since the Unix
+	// epoch. Drivers that never set it are not checked for liveness.
 	LastHB    int64 `json:"lastHB"`
 	Completed bool  `json:"completed"`
 
@@ -162,6 +187,34 @@ type WorkflowDriverStatus struct {
 	CompleteTime *metav1.MicroTime `json:"completeTime,omitempty"`
 }
 
+// WorkflowDriverCompletion records when a driver finished its work for a state
+type WorkflowDriverCompletion struct {
+	DriverID string `json:"driverID"`
+	DWDIndex int    `json:"dwdIndex"`
+
+	// CompleteTime is copied from the driver's entry in the workflow's driver list
+	CompleteTime *metav1.MicroTime `json:"completeTime,omitempty"`
+}
+
+// WorkflowStateHistory records the timing of a state the workflow has entered
+type WorkflowStateHistory struct {
+	// State the workflow transitioned to
+	State WorkflowState `json:"state"`
+
+	// Time of the desiredState change to this state
+	DesiredStateChange *metav1.MicroTime `json:"desiredStateChange,omitempty"`
+
+	// Time the state achieved Ready status. Empty if the workflow left the state
+	// before it became ready.
+	ReadyChange *metav1.MicroTime `json:"readyChange,omitempty"`
+
+	// Duration between the desiredState change and the state becoming ready
+	ElapsedTime string `json:"elapsedTime,omitempty"`
+
+	// Completion times of the drivers registered for this state
+	Drivers []WorkflowDriverCompletion `json:"drivers,omitempty"`
+}
+
 // WorkflowStatus defines the observed state of the Workflow
 type WorkflowStatus struct {
 	// The state the resource is currently transitioning to.
@@ -205,10 +258,13 @@ type WorkflowStatus struct {
 
 	// Duration of the last state change
 	ElapsedTimeLastState string `json:"elapsedTimeLastState,omitempty"`
+
+	// List of the states the workflow has entered, in the order they were entered.
+	// Entries are only appended by the workflow controller.
+	StateHistory []WorkflowStateHistory `json:"stateHistory,omitempty"`
 }
 
 //+kubebuilder:object:root=true
-//+kubebuilder:storageversion
 //+kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state",description="Current state"
 //+kubebuilder:printcolumn:name="READY",type="boolean",JSONPath=".status.ready",description="True if current state is achieved"
 //+kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.status",description="Indicates achievement of current state"
@@ -27,6 +27,7 @@ package v1alpha2
 import (
 	"github.com/HewlettPackard/dws/utils/dwdparse"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -1304,6 +1305,25 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDriverCompletion) DeepCopyInto(out *WorkflowDriverCompletion) {
+	*out = *in
+	if in.CompleteTime != nil {
+		in, out := &in.CompleteTime, &out.CompleteTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDriverCompletion.
+func (in *WorkflowDriverCompletion) DeepCopy() *WorkflowDriverCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDriverCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowDriverStatus) DeepCopyInto(out *WorkflowDriverStatus) {
 	*out = *in
@@ -1364,6 +1384,13 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StateTimeouts != nil {
+		in, out := &in.StateTimeouts, &out.StateTimeouts
+		*out = make(map[WorkflowState]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -1376,6 +1403,36 @@ func (in *WorkflowSpec) DeepCopy() *WorkflowSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowStateHistory) DeepCopyInto(out *WorkflowStateHistory) {
+	*out = *in
+	if in.DesiredStateChange != nil {
+		in, out := &in.DesiredStateChange, &out.DesiredStateChange
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyChange != nil {
+		in, out := &in.ReadyChange, &out.ReadyChange
+		*out = (*in).DeepCopy()
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]WorkflowDriverCompletion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStateHistory.
+func (in *WorkflowStateHistory) DeepCopy() *WorkflowStateHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowStateHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
@@ -1407,6 +1464,13 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 		in, out := &in.ReadyChange, &out.ReadyChange
 		*out = (*in).DeepCopy()
 	}
+	if in.StateHistory != nil {
+		in, out := &in.StateHistory, &out.StateHistory
+		*out = make([]WorkflowStateHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStatus.
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types reported in the status of DWS resources
+const (
+	// ConditionTypeDirectivesValid indicates whether the #DW directives of a Workflow were
+	// accepted by the DWDirectiveRules
+	ConditionTypeDirectivesValid = "DirectivesValid"
+
+	// ConditionTypeComputesAssigned indicates whether the WLM has filled in the compute
+	// nodes in the Computes resource of a Workflow
+	ConditionTypeComputesAssigned = "ComputesAssigned"
+
+	// ConditionTypeDriversReady indicates whether all the drivers registered for the
+	// current state of a Workflow have completed
+	ConditionTypeDriversReady = "DriversReady"
+
+	// ConditionTypeReady indicates whether a DirectiveBreakdown, Servers, or ClientMount
+	// resource has achieved its desired state
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeError indicates whether the resource is reporting an error
+	ConditionTypeError = "Error"
+
+	// ConditionTypeSuspended indicates whether a Workflow is suspended
+	ConditionTypeSuspended = "Suspended"
+)
+
+// Reasons used in the conditions of DWS resources
+const (
+	ConditionReasonSuccess            = "Success"
+	ConditionReasonPending            = "Pending"
+	ConditionReasonDriverWait         = "DriverWait"
+	ConditionReasonTransientCondition = "TransientCondition"
+	ConditionReasonError              = "Error"
+	ConditionReasonSuspended          = "Suspended"
+)
+
+// SetCondition adds or updates the condition of the given type. The LastTransitionTime
+// of an existing condition is only changed when its status changes.
+func SetCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status bool, reason string, message string) {
+	conditionStatus := metav1.ConditionFalse
+	if status {
+		conditionStatus = metav1.ConditionTrue
+	}
+
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// SetErrorCondition sets the Error condition from the error information of the resource.
+// A nil error sets the condition to false.
+func SetErrorCondition(conditions *[]metav1.Condition, generation int64, resourceError *ResourceErrorInfo) {
+	if resourceError == nil {
+		SetCondition(conditions, generation, ConditionTypeError, false, ConditionReasonSuccess, "")
+		return
+	}
+
+	reason := ConditionReasonError
+	if resourceError.Recoverable {
+		reason = ConditionReasonTransientCondition
+	}
+
+	SetCondition(conditions, generation, ConditionTypeError, true, reason, resourceError.Error())
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"strconv"
+	"strings"
+
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+)
+
+func (*ClientMount) Hub()               {}
+func (*Computes) Hub()                  {}
+func (*DWDirectiveRule) Hub()           {}
+func (*DirectiveBreakdown) Hub()        {}
+func (*PersistentStorageInstance) Hub() {}
+func (*Servers) Hub()                   {}
+func (*Storage) Hub()                   {}
+func (*SystemConfiguration) Hub()       {}
+func (*Workflow) Hub()                  {}
+
+// The conversion-verifier tool wants these...though they're never used.
+func (*ClientMountList) Hub()               {}
+func (*ComputesList) Hub()                  {}
+func (*DWDirectiveRuleList) Hub()           {}
+func (*DirectiveBreakdownList) Hub()        {}
+func (*PersistentStorageInstanceList) Hub() {}
+func (*ServersList) Hub()                   {}
+func (*StorageList) Hub()                   {}
+func (*SystemConfigurationList) Hub()       {}
+func (*WorkflowList) Hub()                  {}
+
+// The following conversions are used by the spokes. They live in the hub so conversion-gen
+// finds a single definition for all of the spokes.
+
+// The spokes hold the ClientMount device reference data as an integer. Data that isn't an
+// integer converts to zero and is restored from the annotation on up-conversion.
+func Convert_int_To_string(in *int, out *string, s apiconversion.Scope) error {
+	*out = ""
+	if *in != 0 {
+		*out = strconv.Itoa(*in)
+	}
+
+	return nil
+}
+
+func Convert_string_To_int(in *string, out *int, s apiconversion.Scope) error {
+	*out, _ = strconv.Atoi(*in)
+	return nil
+}
+
+// The spokes hold a rule's watch states as a comma separated string
+
+func Convert_dwdparse_DWDirectiveRuleSpec_To_v1alpha3_DWDirectiveRuleSpec(in *dwdparse.DWDirectiveRuleSpec, out *DWDirectiveRuleSpec, s apiconversion.Scope) error {
+	out.Command = in.Command
+	out.DriverLabel = in.DriverLabel
+	out.RuleDefs = in.RuleDefs
+
+	out.WatchStates = nil
+	if len(in.WatchStates) > 0 {
+		for _, state := range strings.Split(in.WatchStates, ",") {
+			out.WatchStates = append(out.WatchStates, WorkflowState(state))
+		}
+	}
+
+	return nil
+}
+
+func Convert_v1alpha3_DWDirectiveRuleSpec_To_dwdparse_DWDirectiveRuleSpec(in *DWDirectiveRuleSpec, out *dwdparse.DWDirectiveRuleSpec, s apiconversion.Scope) error {
+	*out = in.ParserSpec()
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2021, 2022 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"strings"
+
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DWDirectiveRuleSpec defines the rule for a #DW command
+type DWDirectiveRuleSpec struct {
+	// Name of the #DW command. jobdw, stage_in, etc.
+	Command string `json:"command"`
+
+	// Override for the Driver ID. If left empty this defaults to the
+	// name of the DWDirectiveRule
+	DriverLabel string `json:"driverLabel,omitempty"`
+
+	// List of states that this rule wants to register for. These watch states
+	// will result in an entry in the driver status array in the Workflow resource
+	WatchStates []WorkflowState `json:"watchStates,omitempty"`
+
+	// Priority orders the drivers that register for the same watch state. A driver
+	// is not eligible to run in a state until the drivers with a lower priority have
+	// completed that state.
+	// +kubebuilder:validation:Minimum:=0
+	Priority int `json:"priority,omitempty"`
+
+	// List of driver labels that must complete a watch state before this driver
+	// is eligible to run in that state
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// List of key/value pairs this #DW command is expected to have
+	RuleDefs []dwdparse.DWDirectiveRuleDef `json:"ruleDefs"`
+}
+
+// ParserSpec returns the rule in the form used by the dwdparse package
+func (r *DWDirectiveRuleSpec) ParserSpec() dwdparse.DWDirectiveRuleSpec {
+	states := []string{}
+	for _, state := range r.WatchStates {
+		states = append(states, string(state))
+	}
+
+	return dwdparse.DWDirectiveRuleSpec{
+		Command:     r.Command,
+		DriverLabel: r.DriverLabel,
+		WatchStates: strings.Join(states, ","),
+		RuleDefs:    r.RuleDefs,
+	}
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// DWDirectiveRule is the Schema for the DWDirective API
+type DWDirectiveRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec []DWDirectiveRuleSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DWDirectiveRuleList contains a list of DWDirective
+type DWDirectiveRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DWDirectiveRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DWDirectiveRule{}, &DWDirectiveRuleList{})
+}
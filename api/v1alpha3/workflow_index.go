@@ -0,0 +1,86 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"context"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Names of the Workflow fields indexed by IndexWorkflowFields. A cached List matches a single
+// indexed field, so the job field combines the WLM ID and the job ID.
+const (
+	WorkflowWLMIDField   = "spec.wlmID"
+	WorkflowJobField     = "spec.wlmID.jobID"
+	WorkflowUserIDField  = "spec.userID"
+	WorkflowGroupIDField = "spec.groupID"
+)
+
+// workflowJobKey returns the value of the job field for the WLM ID and job ID
+func workflowJobKey(wlmID string, jobID intstr.IntOrString) string {
+	return wlmID + "/" + jobID.String()
+}
+
+// IndexWorkflowFields adds the Workflow field indexes to a manager's cache. WLM plugins and
+// drivers call it with mgr.GetFieldIndexer() before the manager starts, then List workflows
+// from the cache with MatchingWLMField, MatchingJobField, MatchingUserField, or MatchingGroupField.
+// Unlike the index labels, the fields hold the IDs exactly as they appear in the spec.
+func IndexWorkflowFields(ctx context.Context, indexer client.FieldIndexer) error {
+	indexes := map[string]func(*Workflow) string{
+		WorkflowWLMIDField:   func(w *Workflow) string { return w.Spec.WLMID },
+		WorkflowJobField:     func(w *Workflow) string { return workflowJobKey(w.Spec.WLMID, w.Spec.JobID) },
+		WorkflowUserIDField:  func(w *Workflow) string { return strconv.FormatUint(uint64(w.Spec.UserID), 10) },
+		WorkflowGroupIDField: func(w *Workflow) string { return strconv.FormatUint(uint64(w.Spec.GroupID), 10) },
+	}
+
+	for field, value := range indexes {
+		value := value
+		if err := indexer.IndexField(ctx, &Workflow{}, field, func(o client.Object) []string {
+			return []string{value(o.(*Workflow))}
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MatchingWLMField returns the MatchingFields to match the workflows of a WLM
+func MatchingWLMField(wlmID string) client.MatchingFields {
+	return client.MatchingFields{WorkflowWLMIDField: wlmID}
+}
+
+// MatchingJobField returns the MatchingFields to match the workflows of a WLM's job
+func MatchingJobField(wlmID string, jobID intstr.IntOrString) client.MatchingFields {
+	return client.MatchingFields{WorkflowJobField: workflowJobKey(wlmID, jobID)}
+}
+
+// MatchingUserField returns the MatchingFields to match the workflows of a user
+func MatchingUserField(userID uint32) client.MatchingFields {
+	return client.MatchingFields{WorkflowUserIDField: strconv.FormatUint(uint64(userID), 10)}
+}
+
+// MatchingGroupField returns the MatchingFields to match the workflows of a group
+func MatchingGroupField(groupID uint32) client.MatchingFields {
+	return client.MatchingFields{WorkflowGroupIDField: strconv.FormatUint(uint64(groupID), 10)}
+}
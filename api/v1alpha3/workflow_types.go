@@ -0,0 +1,754 @@
+/*
+ * Copyright 2021, 2022 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/HewlettPackard/dws/utils/updater"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// WorkflowNameLabel is defined for resources that relate to the name of a DWS Workflow
+	WorkflowNameLabel = "dws.cray.hpe.com/workflow.name"
+
+	// WorkflowNamespaceLabel is defined for resources that relate to the namespace of a DWS Workflow
+	WorkflowNamespaceLabel = "dws.cray.hpe.com/workflow.namespace"
+
+	// WorkflowWLMIDLabel is set on a Workflow by the mutating webhook to its normalized spec.wlmID
+	WorkflowWLMIDLabel = "dws.cray.hpe.com/workflow.wlm-id"
+
+	// WorkflowJobIDLabel is set on a Workflow by the mutating webhook to its normalized spec.jobID
+	WorkflowJobIDLabel = "dws.cray.hpe.com/workflow.job-id"
+
+	// WorkflowUserIDLabel is set on a Workflow by the mutating webhook to its spec.userID
+	WorkflowUserIDLabel = "dws.cray.hpe.com/workflow.user-id"
+
+	// WorkflowGroupIDLabel is set on a Workflow by the mutating webhook to its spec.groupID
+	WorkflowGroupIDLabel = "dws.cray.hpe.com/workflow.group-id"
+)
+
+// WorkflowState is the enumeration of the state of the workflow
+// +kubebuilder:validation:Enum:=Proposal;Setup;DataIn;PreRun;PostRun;DataOut;Teardown
+type WorkflowState string
+
+// WorkflowState values
+const (
+	StateProposal WorkflowState = "Proposal"
+	StateSetup    WorkflowState = "Setup"
+	StateDataIn   WorkflowState = "DataIn"
+	StatePreRun   WorkflowState = "PreRun"
+	StatePostRun  WorkflowState = "PostRun"
+	StateDataOut  WorkflowState = "DataOut"
+	StateTeardown WorkflowState = "Teardown"
+)
+
+// Next reports the next state after state s
+func (s WorkflowState) next() WorkflowState {
+	switch s {
+	case "":
+		return StateProposal
+	case StateProposal:
+		return StateSetup
+	case StateSetup:
+		return StateDataIn
+	case StateDataIn:
+		return StatePreRun
+	case StatePreRun:
+		return StatePostRun
+	case StatePostRun:
+		return StateDataOut
+	case StateDataOut:
+		return StateTeardown
+	}
+
+	panic(s)
+}
+
+// Last reports whether the state s is the last state
+func (s WorkflowState) last() bool {
+	return s == StateTeardown
+}
+
+// After reports whether the state s is after t
+func (s WorkflowState) after(t WorkflowState) bool {
+
+	for !t.last() {
+		next := t.next()
+		if s == next {
+			return true
+		}
+		t = next
+	}
+
+	return false
+}
+
+// Strings associated with workflow statuses
+const (
+	StatusPending    = "Pending"
+	StatusQueued     = "Queued"
+	StatusRunning    = "Running"
+	StatusCompleted  = "Completed"
+	StatusError      = "Error"
+	StatusDriverWait = "DriverWait"
+
+	// StatusTransientCondition is set on the workflow when a driver reports an error that
+	// it considers recoverable. StatusError is only set on the workflow for fatal errors.
+	StatusTransientCondition = "TransientCondition"
+
+	// StatusCancelled is set by the workflow controller on a driver entry whose state
+	// was skipped because the workflow went straight to Teardown. The driver never
+	// ran that state.
+	StatusCancelled = "Cancelled"
+)
+
+// WorkflowTimeoutPolicy is the enumeration of the actions taken when a state exceeds its timeout
+// +kubebuilder:validation:Enum:=Error;HurriedTeardown
+type WorkflowTimeoutPolicy string
+
+// WorkflowTimeoutPolicy values
+const (
+	// TimeoutPolicyError marks the workflow with Status=Error when a state times out
+	TimeoutPolicyError WorkflowTimeoutPolicy = "Error"
+
+	// TimeoutPolicyHurriedTeardown marks the workflow with Status=Error and moves the
+	// workflow to Teardown with the hurry flag set when a state times out
+	TimeoutPolicyHurriedTeardown WorkflowTimeoutPolicy = "HurriedTeardown"
+)
+
+// WorkflowSpec defines the desired state of Workflow
+type WorkflowSpec struct {
+	// Desired state for the workflow to be in. Unless progressing to the teardown state,
+	// this can only be set to the next state when the current desired state has been achieved.
+	DesiredState WorkflowState `json:"desiredState"`
+
+	// WLMID identifies the Workflow Manager (WLM), and is set by the WLM
+	// when it creates the workflow resource.
+	WLMID string `json:"wlmID"`
+
+	// JobID is the WLM job ID that corresponds to this workflow, and is
+	// set by the WLM when it creates the workflow resource.
+	JobID intstr.IntOrString `json:"jobID"`
+
+	// UserID specifies the user ID for the workflow. The User ID is used by the various states
+	// in the workflow to ensure the user has permissions to perform certain actions. Used in
+	// conjunction with Group ID to run subtasks with UserID:GroupID credentials
+	UserID uint32 `json:"userID"`
+
+	// GroupID specifies the group ID for the workflow. The Group ID is used by the various states
+	// in the workflow to ensure the group has permissions to perform certain actions. Used in
+	// conjunction with User ID to run subtasks with UserID:GroupID credentials.
+	GroupID uint32 `json:"groupID"`
+
+	// Hurry indicates that the workflow's driver should kill the job in a hurry when this workflow enters its teardown state.
+	// The driver must release all resources and unmount any filesystems that were mounted as part of the workflow, though some drivers would have done this anyway as part of their teardown state.
+	// The driver must also kill any in-progress data transfers, or skip any data transfers that have not yet begun.
+	// +kubebuilder:default:=false
+	Hurry bool `json:"hurry,omitempty"`
+
+	// List of #DW strings from a WLM job script
+	DWDirectives []string `json:"dwDirectives"`
+
+	// StateTimeouts sets the maximum time the workflow may spend in a state before it is
+	// marked as an error. The time is measured from the most recent desiredState change.
+	// States without an entry have no timeout.
+	StateTimeouts map[WorkflowState]metav1.Duration `json:"stateTimeouts,omitempty"`
+
+	// TimeoutPolicy specifies the action taken when a state exceeds its timeout. An empty
+	// value is equivalent to Error.
+	TimeoutPolicy WorkflowTimeoutPolicy `json:"timeoutPolicy,omitempty"`
+
+	// RetryGeneration is incremented by the WLM to retry the current state after a driver
+	// error. When it changes, the workflow controller resets the driver entries for the current
+	// state that have not completed back to Pending and clears their errors. It may not decrease.
+	// +kubebuilder:validation:Minimum:=0
+	RetryGeneration int64 `json:"retryGeneration,omitempty"`
+
+	// AutoAdvance lets the workflow controller advance desiredState on its own through the
+	// states that have no registered drivers. The WLM must still advance the workflow into
+	// any state that has drivers.
+	AutoAdvance *WorkflowAutoAdvance `json:"autoAdvance,omitempty"`
+
+	// Suspend freezes the workflow in its current state. While it is set, desiredState may
+	// only be changed to Teardown. Drivers should pause any long-running work, such as data
+	// movement, until it is cleared. The state's timeout is paused and a finished workflow
+	// isn't deleted while the workflow is suspended.
+	// +kubebuilder:default:=false
+	Suspend bool `json:"suspend,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds the workflow is kept after it is
+	// Ready in Teardown. The workflow controller deletes the workflow once it expires. If
+	// it's not set, the controller's cluster-wide default applies.
+	// +kubebuilder:validation:Minimum:=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Predecessor is an earlier workflow in the same namespace that hands some of its jobdw
+	// allocations to this workflow when it reaches Teardown, rather than destroying them.
+	// The predecessor must belong to the same user and group.
+	Predecessor *WorkflowPredecessor `json:"predecessor,omitempty"`
+}
+
+// WorkflowPredecessor identifies the workflow and the allocations handed to a successor workflow
+type WorkflowPredecessor struct {
+	// Name of the predecessor workflow
+	Name string `json:"name"`
+
+	// Indexes of the jobdw directives in the predecessor's spec.dwDirectives whose allocations
+	// are handed to this workflow
+	// +kubebuilder:validation:MinItems=1
+	DWDIndexes []int `json:"dwdIndexes"`
+}
+
+// WorkflowAutoAdvance defines how far the workflow controller may advance a workflow on its own
+type WorkflowAutoAdvance struct {
+	// StopState is the last state the workflow controller may advance the workflow to.
+	// The WLM advances the workflow past it.
+	// +kubebuilder:validation:Enum=Setup;DataIn;PreRun;PostRun;DataOut
+	StopState WorkflowState `json:"stopState"`
+}
+
+// WorkflowDriverStatus defines the status information provided by integration drivers.
+type WorkflowDriverStatus struct {
+	DriverID string `json:"driverID"`
+	TaskID   string `json:"taskID"`
+	DWDIndex int    `json:"dwdIndex"`
+
+	WatchState WorkflowState `json:"watchState"`
+
+	// LastHB is the time of the driver's most recent heartbeat. Drivers that never set
+	// it are not checked for liveness.
+	LastHB    *metav1.Time `json:"lastHB,omitempty"`
+	Completed bool         `json:"completed"`
+
+	// Unresponsive is set by the workflow controller while the driver's heartbeat is stale.
+	// The driver's status is left as the driver reported it.
+	Unresponsive bool `json:"unresponsive,omitempty"`
+
+	// User readable reason.
+	// For the CDS driver, this could be the state of the underlying
+	// data movement request:  Pending, Queued, Running, Completed or Error.
+	// Cancelled is set by the workflow controller when the workflow skips the driver's watch
+	// state on its way to Teardown. spec.hurry indicates whether that Teardown is hurried.
+	// +kubebuilder:validation:Enum=Pending;Queued;Running;Completed;Error;DriverWait;Cancelled
+	Status string `json:"status,omitempty"`
+
+	// Message provides additional details on the current status of the resource
+	Message string `json:"message,omitempty"`
+
+	// Driver error information. The recoverable flag decides whether the workflow's
+	// status is TransientCondition or Error when the driver's status is Error. A
+	// missing error is treated as fatal.
+	Error *ResourceErrorInfo `json:"error,omitempty"`
+
+	// CompleteTime reflects the time that the workflow reconciler marks the driver complete
+	CompleteTime *metav1.MicroTime `json:"completeTime,omitempty"`
+
+	// CancelTime reflects the time that the workflow reconciler marks the driver cancelled
+	CancelTime *metav1.MicroTime `json:"cancelTime,omitempty"`
+
+	// ErrorTime reflects the time that the workflow reconciler first saw the driver's current
+	// error. It's cleared when the driver's status is no longer Error.
+	ErrorTime *metav1.MicroTime `json:"errorTime,omitempty"`
+
+	// Priority is copied from the DWDirectiveRule that registered the driver. The driver
+	// waits for the entries in the same watch state with a lower priority.
+	Priority int `json:"priority,omitempty"`
+
+	// DependsOn is copied from the DWDirectiveRule that registered the driver. The driver
+	// waits for the entries in the same watch state with these driver IDs.
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Eligible is set by the workflow controller once the entries this driver waits for
+	// have completed the current state. A driver should not start its work for the
+	// state until it is eligible.
+	Eligible bool `json:"eligible,omitempty"`
+}
+
+// WaitsFor returns true if the driver entry can't run until the other entry has completed
+// its watch state
+func (d *WorkflowDriverStatus) WaitsFor(other *WorkflowDriverStatus) bool {
+	if d.WatchState != other.WatchState || (d.DriverID == other.DriverID && d.DWDIndex == other.DWDIndex) {
+		return false
+	}
+
+	if other.Priority < d.Priority {
+		return true
+	}
+
+	for _, driverID := range d.DependsOn {
+		if driverID == other.DriverID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WorkflowDriverCompletion records when a driver finished its work for a state
+type WorkflowDriverCompletion struct {
+	DriverID string `json:"driverID"`
+	DWDIndex int    `json:"dwdIndex"`
+
+	// CompleteTime is copied from the driver's entry in the workflow's driver list
+	CompleteTime *metav1.MicroTime `json:"completeTime,omitempty"`
+}
+
+// WorkflowStateHistory records the timing of a state the workflow has entered
+type WorkflowStateHistory struct {
+	// State the workflow transitioned to
+	State WorkflowState `json:"state"`
+
+	// Time of the desiredState change to this state
+	DesiredStateChange *metav1.MicroTime `json:"desiredStateChange,omitempty"`
+
+	// Time the state achieved Ready status. Empty if the workflow left the state
+	// before it became ready.
+	ReadyChange *metav1.MicroTime `json:"readyChange,omitempty"`
+
+	// Duration between the desiredState change and the state becoming ready
+	ElapsedTime string `json:"elapsedTime,omitempty"`
+
+	// Completion times of the drivers registered for this state
+	Drivers []WorkflowDriverCompletion `json:"drivers,omitempty"`
+}
+
+// WorkflowDirectiveSummary rolls up the driver entries registered for a single #DW directive
+// in the workflow's current state
+type WorkflowDirectiveSummary struct {
+	// Index of the directive in spec.dwDirectives
+	DWDIndex int `json:"dwdIndex"`
+
+	// Command of the directive, e.g. jobdw
+	Command string `json:"command"`
+
+	// IDs of the drivers registered for the directive in the current state
+	DriverIDs []string `json:"driverIDs,omitempty"`
+
+	// Number of the directive's drivers that have completed the current state
+	Completed int `json:"completed"`
+
+	// Most severe status of the directive's drivers
+	// +kubebuilder:validation:Enum=Completed;DriverWait;TransientCondition;Error
+	Status string `json:"status,omitempty"`
+
+	// Lists the directive's drivers that are waiting or have failed
+	Message string `json:"message,omitempty"`
+}
+
+// WorkflowHandoff records a jobdw allocation handed from a predecessor workflow to its successor.
+// The same entry is kept in the status of both workflows.
+type WorkflowHandoff struct {
+	// Name of the predecessor workflow handing off the allocation
+	Predecessor string `json:"predecessor"`
+
+	// Name of the successor workflow receiving the allocation
+	Successor string `json:"successor"`
+
+	// Index of the jobdw directive in the predecessor's spec.dwDirectives
+	DWDIndex int `json:"dwdIndex"`
+
+	// The predecessor's jobdw directive
+	Directive string `json:"directive,omitempty"`
+
+	// Pending until the predecessor is Ready in Teardown, when its drivers have handed the
+	// allocation to the successor. Error if the predecessor went away without handing it off, or
+	// finished Teardown without its driver setting handedOff.
+	// +kubebuilder:validation:Enum=Pending;Completed;Error
+	Status string `json:"status"`
+
+	// HandedOff is set by the predecessor's driver once it has handed the allocation to the
+	// successor, such as with HandOffChildren
+	HandedOff bool `json:"handedOff,omitempty"`
+
+	// Message provides additional details on the status of the handoff
+	Message string `json:"message,omitempty"`
+
+	// Time the predecessor completed the handoff
+	CompleteTime *metav1.MicroTime `json:"completeTime,omitempty"`
+}
+
+// EnvSourceDWS is the driver ID recorded for the environment variables that DWS sets itself
+const EnvSourceDWS = "dws"
+
+// EnvSourceEnv is the driver ID recorded for the environment variables that were set directly in
+// the Env view, such as by a driver using an API version without EnvVars
+const EnvSourceEnv = "env"
+
+// WorkflowEnvVar is an environment variable for the WLM to apply to the job
+type WorkflowEnvVar struct {
+	// Name of the environment variable
+	Name string `json:"name"`
+
+	// Value of the environment variable
+	Value string `json:"value"`
+
+	// ID of the driver that set the variable. "dws" for the variables set by DWS.
+	DriverID string `json:"driverID,omitempty"`
+
+	// Index of the #DW directive the variable was set for. -1 if the variable isn't
+	// related to a directive.
+	DWDIndex int `json:"dwdIndex"`
+}
+
+// WorkflowExplanation is returned in the WorkflowExplanationAnnotation of a server-side dry-run
+// create. It describes how the directives matched the rules, and the driver entries and
+// environment variables the workflow would start with.
+type WorkflowExplanation struct {
+	// The rule that matched each directive
+	Directives []WorkflowDirectiveExplanation `json:"directives,omitempty"`
+
+	// The driver entries that would be registered for the workflow
+	Drivers []WorkflowDriverStatus `json:"drivers,omitempty"`
+
+	// The environment variables that would be set for the workflow
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// WorkflowDirectiveExplanation describes a DWDirectiveRule rule that matched a #DW directive
+type WorkflowDirectiveExplanation struct {
+	// Index of the directive in spec.dwDirectives
+	DWDIndex int `json:"dwdIndex"`
+
+	// The #DW directive
+	Directive string `json:"directive"`
+
+	// Name of the DWDirectiveRule containing the rule that matched the directive
+	RuleSet string `json:"ruleSet"`
+
+	// Command of the rule that matched the directive
+	Command string `json:"command"`
+
+	// ID of the driver that registers for the directive
+	DriverID string `json:"driverID"`
+
+	// States the driver registers for. Empty if the driver doesn't register for any state.
+	WatchStates []WorkflowState `json:"watchStates,omitempty"`
+}
+
+// WorkflowStatus defines the observed state of the Workflow
+type WorkflowStatus struct {
+	// The state the resource is currently transitioning to.
+	// Updated by the controller once started.
+	State WorkflowState `json:"state,omitempty"`
+
+	// Ready can be 'True', 'False'
+	// Indicates whether State has been reached.
+	Ready bool `json:"ready"`
+
+	// User readable reason and status message.
+	// TransientCondition means a driver reported a recoverable error and the state may be
+	// retried. Error means a driver reported a fatal error or the state timed out.
+	// +kubebuilder:validation:Enum=Completed;DriverWait;TransientCondition;Error
+	Status string `json:"status,omitempty"`
+
+	// Error information for the driver error that set Status to TransientCondition or Error
+	ResourceError `json:",inline"`
+
+	// Message provides additional details on the current status of the resource. It lists
+	// every driver for the current state that is waiting or has failed.
+	Message string `json:"message,omitempty"`
+
+	// Summary of the drivers for the current state for each #DW directive that has any
+	DirectiveSummaries []WorkflowDirectiveSummary `json:"directiveSummaries,omitempty"`
+
+	// Set of DW environment variable settings for WLM to apply to the job.
+	//		- DW_JOB_STRIPED
+	//		- DW_JOB_PRIVATE
+	//		- DW_JOB_STRIPED_CACHE
+	//		- DW_JOB_LDBAL_CACHE
+	//		- DW_PERSISTENT_STRIPED_{resname}
+	// This is a view of EnvVars kept up to date by DWS. Drivers should add their
+	// variables to EnvVars. Variables added here directly are moved into EnvVars
+	// by the workflow controller with no source.
+	Env map[string]string `json:"env,omitempty"`
+
+	// List of DW environment variables for the WLM to apply to the job along with the
+	// driver and directive that set each one. Each variable may only be set once.
+	EnvVars []WorkflowEnvVar `json:"envVars,omitempty"`
+
+	// List of registered drivers and related status.  Updated by drivers.
+	Drivers []WorkflowDriverStatus `json:"drivers,omitempty"`
+
+	// List of #DW directive breakdowns indicating to WLM what to allocate on what Server
+	// 1 DirectiveBreakdown per #DW Directive that requires storage
+	DirectiveBreakdowns []corev1.ObjectReference `json:"directiveBreakdowns,omitempty"`
+
+	// Reference to Computes
+	Computes corev1.ObjectReference `json:"computes,omitempty"`
+
+	// Time of the most recent desiredState change
+	DesiredStateChange *metav1.MicroTime `json:"desiredStateChange,omitempty"`
+
+	// Time of the most recent desiredState's achieving Ready status
+	ReadyChange *metav1.MicroTime `json:"readyChange,omitempty"`
+
+	// Duration of the last state change
+	ElapsedTimeLastState string `json:"elapsedTimeLastState,omitempty"`
+
+	// The most recent spec.retryGeneration acted on by the workflow controller
+	ObservedRetryGeneration int64 `json:"observedRetryGeneration,omitempty"`
+
+	// Time of the most recent retry. A state timeout is measured from the later of this and
+	// desiredStateChange.
+	RetryChange *metav1.MicroTime `json:"retryChange,omitempty"`
+
+	// Time the workflow was most recently suspended. Cleared when spec.suspend is cleared.
+	SuspendChange *metav1.MicroTime `json:"suspendChange,omitempty"`
+
+	// Total time the workflow has spent suspended since the state's timeout started. The
+	// state's timeout is extended by this amount.
+	SuspendedTime *metav1.Duration `json:"suspendedTime,omitempty"`
+
+	// List of the states the workflow has entered, in the order they were entered.
+	// Entries are only appended by the workflow controller.
+	StateHistory []WorkflowStateHistory `json:"stateHistory,omitempty"`
+
+	// Allocations handed from a predecessor workflow to a successor. A predecessor lists the
+	// allocations it hands off, and a successor lists the allocations it receives. A
+	// successor isn't Ready in Setup until each of its handoffs has completed.
+	Handoffs []WorkflowHandoff `json:"handoffs,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state",description="Current state"
+//+kubebuilder:printcolumn:name="READY",type="boolean",JSONPath=".status.ready",description="True if current state is achieved"
+//+kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.status",description="Indicates achievement of current state"
+//+kubebuilder:printcolumn:name="JOBID",type="string",JSONPath=".spec.jobID",description="Job ID"
+//+kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+//+kubebuilder:printcolumn:name="DESIREDSTATE",type="string",JSONPath=".spec.desiredState",description="Desired state",priority=1
+//+kubebuilder:printcolumn:name="DESIREDSTATECHANGE",type="date",JSONPath=".status.desiredStateChange",description="Time of most recent desiredState change",priority=1
+//+kubebuilder:printcolumn:name="ELAPSEDTIMELASTSTATE",type="string",JSONPath=".status.elapsedTimeLastState",description="Duration of last state change",priority=1
+
+// Workflow is the Schema for the workflows API
+type Workflow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowSpec   `json:"spec,omitempty"`
+	Status WorkflowStatus `json:"status,omitempty"`
+}
+
+func (c *Workflow) GetStatus() updater.Status[*WorkflowStatus] {
+	return &c.Status
+}
+
+// NextAutoAdvanceState returns the state the workflow controller may advance the workflow to
+// under its auto advance policy. The current state must be ready, and the next state must have
+// no registered drivers and must not come after the stop state.
+func (w *Workflow) NextAutoAdvanceState() (WorkflowState, bool) {
+	if w.Spec.AutoAdvance == nil || w.Spec.Suspend || !w.Status.Ready || w.Status.State == "" || w.Status.State != w.Spec.DesiredState || w.Status.State.last() {
+		return "", false
+	}
+
+	next := w.Status.State.next()
+	if next.after(w.Spec.AutoAdvance.StopState) {
+		return "", false
+	}
+
+	for _, driver := range w.Status.Drivers {
+		if driver.WatchState == next {
+			return "", false
+		}
+	}
+
+	return next, true
+}
+
+// CancelSkippedDrivers marks the incomplete driver entries for the states between the current
+// state and Teardown as Cancelled. It's called as the workflow moves to Teardown, before the
+// current state is updated. Returns the number of entries cancelled.
+func (w *Workflow) CancelSkippedDrivers(ts metav1.MicroTime) int {
+	cancelled := 0
+	for i := range w.Status.Drivers {
+		driver := &w.Status.Drivers[i]
+		if driver.WatchState == StateTeardown || !driver.WatchState.after(w.Status.State) {
+			continue
+		}
+
+		if driver.Completed || driver.Status == StatusCancelled {
+			continue
+		}
+
+		driver.Status = StatusCancelled
+		driver.CancelTime = ts.DeepCopy()
+		cancelled++
+	}
+
+	return cancelled
+}
+
+// SetEnv adds the environment variable to EnvVars, or updates its value if it was already set
+// by the same driver for the same directive, and refreshes the Env view. Returns an error if
+// the variable was set by a different source.
+func (s *WorkflowStatus) SetEnv(name string, value string, driverID string, dwdIndex int) error {
+	for i := range s.EnvVars {
+		envVar := &s.EnvVars[i]
+		if envVar.Name != name {
+			continue
+		}
+
+		if envVar.DriverID != driverID || envVar.DWDIndex != dwdIndex {
+			return fmt.Errorf("environment variable %s was already set by driver %s for DW Directive %d", name, envVar.DriverID, envVar.DWDIndex)
+		}
+
+		envVar.Value = value
+		s.Env = s.EnvMap()
+
+		return nil
+	}
+
+	s.EnvVars = append(s.EnvVars, WorkflowEnvVar{Name: name, Value: value, DriverID: driverID, DWDIndex: dwdIndex})
+	s.Env = s.EnvMap()
+
+	return nil
+}
+
+// EnvMap returns the Env view of EnvVars
+func (s *WorkflowStatus) EnvMap() map[string]string {
+	if len(s.EnvVars) == 0 {
+		return nil
+	}
+
+	env := make(map[string]string, len(s.EnvVars))
+	for _, envVar := range s.EnvVars {
+		env[envVar.Name] = envVar.Value
+	}
+
+	return env
+}
+
+// AdoptEnv moves the variables that were added directly to the Env view into EnvVars with the
+// EnvSourceEnv source, then refreshes the view from EnvVars
+func (s *WorkflowStatus) AdoptEnv() {
+	names := make([]string, 0, len(s.Env))
+	for name := range s.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		found := false
+		for _, envVar := range s.EnvVars {
+			if envVar.Name == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			s.EnvVars = append(s.EnvVars, WorkflowEnvVar{Name: name, Value: s.Env[name], DriverID: EnvSourceEnv, DWDIndex: -1})
+		}
+	}
+
+	s.Env = s.EnvMap()
+}
+
+// MergeEnv applies an Env view written through an API version without EnvVars. The variables
+// with the EnvSourceEnv source are changed or removed to match the view, and new variables are
+// added with that source. Returns an error if the view changes or removes a variable that was
+// set by another source.
+func (s *WorkflowStatus) MergeEnv(env map[string]string) error {
+	var envVars []WorkflowEnvVar
+	for _, envVar := range s.EnvVars {
+		value, found := env[envVar.Name]
+		if !found || value != envVar.Value {
+			if envVar.DriverID != EnvSourceEnv {
+				return fmt.Errorf("environment variable %s was set by driver %s for DW Directive %d and can't be changed through env", envVar.Name, envVar.DriverID, envVar.DWDIndex)
+			}
+
+			if !found {
+				continue
+			}
+
+			envVar.Value = value
+		}
+
+		envVars = append(envVars, envVar)
+	}
+
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s.EnvVars = envVars
+	known := s.EnvMap()
+	for _, name := range names {
+		if _, found := known[name]; !found {
+			s.EnvVars = append(s.EnvVars, WorkflowEnvVar{Name: name, Value: env[name], DriverID: EnvSourceEnv, DWDIndex: -1})
+		}
+	}
+
+	s.Env = s.EnvMap()
+
+	return nil
+}
+
+// DriverEligible returns true if every driver entry that the entry at the index waits for
+// has completed
+func (s *WorkflowStatus) DriverEligible(index int) bool {
+	driver := &s.Drivers[index]
+	for i := range s.Drivers {
+		if driver.WaitsFor(&s.Drivers[i]) && !s.Drivers[i].Completed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindHandoff returns the handoff entry for the predecessor's directive and the successor, or nil
+// if there isn't one
+func (s *WorkflowStatus) FindHandoff(predecessor string, dwdIndex int, successor string) *WorkflowHandoff {
+	for i := range s.Handoffs {
+		handoff := &s.Handoffs[i]
+		if handoff.Predecessor == predecessor && handoff.DWDIndex == dwdIndex && handoff.Successor == successor {
+			return handoff
+		}
+	}
+
+	return nil
+}
+
+//+kubebuilder:object:root=true
+
+// WorkflowList contains a list of Workflows
+type WorkflowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workflow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Workflow{}, &WorkflowList{})
+}
@@ -0,0 +1,1009 @@
+/*
+ * Copyright 2021, 2022 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/HewlettPackard/dws/utils/audit"
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+	"github.com/HewlettPackard/dws/utils/tracing"
+)
+
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=dwdirectiverules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflowquotas,verbs=get;list;watch
+
+// log is for logging in this package.
+var workflowlog = logf.Log.WithName("workflow-resource")
+
+var c client.Client
+
+// apiReader reads directly from the API server. It's used where the cache may not have caught
+// up with the workflows admitted moments earlier.
+var apiReader client.Reader
+
+// auditLog records the workflow changes admitted by the validating webhook. Auditing is
+// disabled when it's nil.
+var auditLog *audit.Logger
+
+// SetAuditLogger sets the logger for the audit records written by the workflow webhook
+func SetAuditLogger(logger *audit.Logger) {
+	auditLog = logger
+}
+
+// WorkflowForceDeleteAnnotation allows a workflow to be deleted before it has finished Teardown
+// when it is set to "true". Every forced deletion is logged with the requesting user.
+const WorkflowForceDeleteAnnotation = "dws.cray.hpe.com/force-delete"
+
+// WorkflowExplanationAnnotation holds a JSON WorkflowExplanation in the response to a server-side
+// dry-run create of a workflow. It's removed from workflows that are stored.
+const WorkflowExplanationAnnotation = "dws.cray.hpe.com/explanation"
+
+// WorkflowTraceParentAnnotation holds the W3C traceparent of the root span of the workflow's
+// trace. It's set when the workflow is created. Drivers and WLM plugins can add their own spans
+// to the trace as children of this span.
+const WorkflowTraceParentAnnotation = "dws.cray.hpe.com/traceparent"
+
+// SetupWebhookWithManager connects the webhook with the manager
+func (w *Workflow) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	c = mgr.GetClient()
+	apiReader = mgr.GetAPIReader()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(w).
+		WithDefaulter(&workflowDefaulter{}).
+		WithValidator(&workflowValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-dws-cray-hpe-com-v1alpha3-workflow,mutating=true,failurePolicy=fail,sideEffects=None,groups=dws.cray.hpe.com,resources=workflows,verbs=create,versions=v1alpha3,name=mworkflow.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Defaulter = &Workflow{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (w *Workflow) Default() {
+	w.setDefaults(false)
+}
+
+// setDefaults adds the index labels for the WLM ID, job ID, user ID, and group ID. When explain is
+// set, the drivers are registered on a copy of the workflow and the result is recorded in the
+// WorkflowExplanationAnnotation. The drivers aren't registered on the workflow itself because the
+// API server drops the status of a new workflow. The workflow controller registers them instead.
+func (w *Workflow) setDefaults(explain bool) {
+	workflowlog.Info("default", "name", w.Name, "explain", explain)
+
+	AddWorkflowIndexLabels(w)
+
+	annotations := w.GetAnnotations()
+	delete(annotations, WorkflowExplanationAnnotation)
+
+	if explain {
+		explained := w.DeepCopy()
+		parser := &MutatingRuleParser{Explain: true}
+		if err := explained.registerDrivers(parser); err == nil {
+			explanation, _ := json.Marshal(WorkflowExplanation{
+				Directives: parser.explanations,
+				Drivers:    explained.Status.Drivers,
+				Env:        explained.Status.Env,
+			})
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[WorkflowExplanationAnnotation] = string(explanation)
+		}
+	}
+
+	w.SetAnnotations(annotations)
+}
+
+// RegisterDrivers adds a driver status entry for each state a driver registered for in the
+// DWDirectiveRules matching the workflow's directives, and sets the DWS environment variables.
+// Any existing driver entries are replaced. An error is returned if the priorities and
+// dependencies of the drivers form a cycle.
+func (w *Workflow) RegisterDrivers(reader client.Reader) error {
+	return w.registerDrivers(&MutatingRuleParser{RuleList: RuleList{reader: reader}})
+}
+
+func (w *Workflow) registerDrivers(parser *MutatingRuleParser) error {
+	w.Status.Drivers = nil
+	if err := checkDirectives(w, parser); err != nil {
+		return err
+	}
+
+	if err := validateDriverDependencies(w); err != nil {
+		return err
+	}
+
+	if err := w.Status.SetEnv("DW_WORKFLOW_NAME", w.Name, EnvSourceDWS, -1); err != nil {
+		return err
+	}
+
+	return w.Status.SetEnv("DW_WORKFLOW_NAMESPACE", w.Namespace, EnvSourceDWS, -1)
+}
+
+// workflowDefaulter implements admission.CustomDefaulter with the Workflow's defaulting. It makes
+// the admission request available so a server-side dry-run create can explain the directives.
+// +kubebuilder:object:generate=false
+type workflowDefaulter struct{}
+
+var _ admission.CustomDefaulter = &workflowDefaulter{}
+
+func (d *workflowDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	explain := false
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.DryRun != nil {
+		explain = *req.DryRun
+	}
+
+	w := obj.(*Workflow)
+	w.setDefaults(explain)
+
+	// Start the trace for the new workflow. A dry run doesn't create a workflow to trace. No span
+	// is recorded here because the create may still be rejected. The root span recorded by the
+	// workflow controller starts at the workflow's creation.
+	if !explain {
+		annotations := w.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[WorkflowTraceParentAnnotation] = tracing.NewTraceParent()
+		w.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+// TraceParent returns the W3C traceparent of the root span of the workflow's trace, or an
+// empty string if the workflow isn't traced
+func (w *Workflow) TraceParent() string {
+	return w.GetAnnotations()[WorkflowTraceParentAnnotation]
+}
+
+// TraceAttributes returns the attributes that identify the workflow in its trace
+func (w *Workflow) TraceAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("dws.workflow.name", w.Name),
+		attribute.String("dws.workflow.namespace", w.Namespace),
+		attribute.String("dws.wlm_id", w.Spec.WLMID),
+		attribute.String("dws.job_id", w.Spec.JobID.String()),
+		attribute.Int64("dws.user_id", int64(w.Spec.UserID)),
+		attribute.Int64("dws.group_id", int64(w.Spec.GroupID)),
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-dws-cray-hpe-com-v1alpha3-workflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=dws.cray.hpe.com,resources=workflows;workflows/status,verbs=create;update;delete,versions=v1alpha3,name=vworkflow.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Workflow{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *Workflow) ValidateCreate() error {
+
+	specPath := field.NewPath("Spec")
+
+	if w.Spec.DesiredState != StateProposal {
+		s := fmt.Sprintf("desired state must start in %s", StateProposal)
+		return field.Invalid(specPath.Child("DesiredState"), w.Spec.DesiredState, s)
+	}
+	if w.Spec.Hurry == true {
+		return field.Forbidden(specPath.Child("Hurry"), "the hurry flag may not be set on creation")
+	}
+	if w.Status.State != "" {
+		return field.Forbidden(field.NewPath("Status").Child("State"), "the status state may not be set on creation")
+	}
+
+	if err := validateStateTimeouts(w); err != nil {
+		return err
+	}
+
+	// Register the drivers on a copy of the workflow to check that each directive matches a rule
+	// and the drivers' dependencies don't form a cycle. The API server drops the status of a new
+	// workflow, so the controller registers them again on the stored workflow.
+	if err := w.DeepCopy().registerDrivers(&MutatingRuleParser{}); err != nil {
+		return err
+	}
+
+	if err := validatePredecessor(w); err != nil {
+		return err
+	}
+
+	return checkQuotas(w)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *Workflow) ValidateUpdate(old runtime.Object) error {
+
+	oldWorkflow, ok := old.(*Workflow)
+	if !ok {
+		err := fmt.Errorf("invalid Workflow resource")
+		workflowlog.Error(err, "old runtime.Object is not a Workflow resource")
+
+		return err
+	}
+
+	if w.Spec.Hurry == true && w.Spec.DesiredState != StateTeardown {
+		s := fmt.Sprintf("the hurry flag may be set only in %s", StateTeardown)
+		return field.Invalid(field.NewPath("Spec").Child("Hurry"), w.Spec.Hurry, s)
+	}
+
+	// Check that immutable fields haven't changed.
+	err := validateWorkflowImmutable(w, oldWorkflow)
+	if err != nil {
+		return err
+	}
+
+	if err := validateStateTimeouts(w); err != nil {
+		return err
+	}
+
+	if err := validateEnvVars(w); err != nil {
+		return err
+	}
+
+	if w.Spec.RetryGeneration < oldWorkflow.Spec.RetryGeneration {
+		return field.Invalid(field.NewPath("Spec").Child("RetryGeneration"), w.Spec.RetryGeneration, "retry generation cannot decrease")
+	}
+
+	if err := validateHandoffs(w, oldWorkflow); err != nil {
+		return err
+	}
+
+	if err := validateStateHistory(w, oldWorkflow); err != nil {
+		return err
+	}
+
+	// Initial setup of the Workflow by the dws controller requires setting the status
+	// state to proposal and adding a finalizer.
+	if oldWorkflow.Status.State == "" && w.Spec.DesiredState == StateProposal {
+		return nil
+	}
+
+	if len(w.Status.Drivers) != len(oldWorkflow.Status.Drivers) {
+		return field.Forbidden(field.NewPath("Status").Child("Drivers"), "driver entries may only be added by the workflow controller during Proposal")
+	}
+
+	// Validate the elements in the Drivers array
+	for i, driverStatus := range w.Status.Drivers {
+
+		driverError := func(errString string) error {
+			return field.InternalError(field.NewPath("Status").Child("Drivers").Index(i), fmt.Errorf(errString))
+		}
+
+		// Elements with watchStates not equal to the current state should not change, except
+		// to cancel them when their state is skipped
+		if driverStatus.WatchState != oldWorkflow.Status.State {
+			if !reflect.DeepEqual(oldWorkflow.Status.Drivers[i], driverStatus) && !isDriverCancellation(w, oldWorkflow.Status.Drivers[i], driverStatus) {
+				return driverError("driver entry for non-current state cannot be changed")
+			}
+			continue
+		}
+
+		if driverStatus.Completed == true {
+			if driverStatus.Status != StatusCompleted {
+				return driverError("driver cannot be completed without status=Completed")
+			}
+
+			if driverStatus.Error != nil {
+				return driverError("driver cannot be completed when error is present")
+			}
+
+			if !w.Status.DriverEligible(i) {
+				return driverError("driver cannot be completed before the drivers it depends on")
+			}
+		} else {
+			if oldWorkflow.Status.Drivers[i].Completed == true {
+				return driverError("driver cannot change from completed state")
+			}
+		}
+	}
+
+	oldState := oldWorkflow.Status.State
+	newState := w.Spec.DesiredState
+
+	// Progressing to teardown is allowed at any time, and changes to the
+	// Workflow that don't change the state are fine too (immutable fields were
+	// already checked)
+	if newState == StateTeardown || newState == oldState {
+		return nil
+	}
+
+	if w.Spec.Suspend {
+		return field.Invalid(field.NewPath("Spec").Child("DesiredState"), w.Spec.DesiredState, "DesiredState may only change to Teardown while the workflow is suspended")
+	}
+
+	// Error checks
+	if oldState.after(newState) {
+		return field.Invalid(field.NewPath("Spec").Child("DesiredState"), w.Spec.DesiredState, "DesiredState cannot progress backwards")
+	}
+
+	if oldState.next() != newState {
+		return field.Invalid(field.NewPath("Spec").Child("DesiredState"), w.Spec.DesiredState, "states cannot be skipped")
+	}
+
+	if !oldWorkflow.Status.Ready {
+		return field.Invalid(field.NewPath("Status").Child("State"), oldWorkflow.Status.State, "current desired state not yet achieved")
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+// A workflow may only be deleted once it's Ready in Teardown, when the hurry flag is set,
+// or when the force delete annotation is set.
+func (w *Workflow) ValidateDelete() error {
+	if w.isDeletable() || w.isForceDeleted() {
+		return nil
+	}
+
+	s := fmt.Sprintf("workflow must be ready in %s before it's deleted unless the hurry flag or the %s annotation is set", StateTeardown, WorkflowForceDeleteAnnotation)
+	return field.Forbidden(field.NewPath("Status").Child("State"), s)
+}
+
+// isDeletable returns true if the workflow may be deleted without the force delete annotation
+func (w *Workflow) isDeletable() bool {
+	return (w.Status.State == StateTeardown && w.Status.Ready) || w.Spec.Hurry
+}
+
+// isForceDeleted returns true if the force delete annotation is set on the workflow
+func (w *Workflow) isForceDeleted() bool {
+	return w.GetAnnotations()[WorkflowForceDeleteAnnotation] == "true"
+}
+
+// workflowValidator implements admission.CustomValidator with the Workflow's webhook.Validator
+// methods. It makes the admission request available so forced deletions can be logged with the
+// requesting user.
+// +kubebuilder:object:generate=false
+type workflowValidator struct{}
+
+var _ admission.CustomValidator = &workflowValidator{}
+
+func (v *workflowValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	w := obj.(*Workflow)
+	if err := w.ValidateCreate(); err != nil {
+		return err
+	}
+
+	writeAuditRecords(ctx, w, audit.Record{Action: audit.ActionCreate, To: string(w.Spec.DesiredState)})
+
+	return nil
+}
+
+func (v *workflowValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	w := newObj.(*Workflow)
+	if err := w.ValidateUpdate(oldObj); err != nil {
+		return err
+	}
+
+	writeAuditRecords(ctx, w, auditUpdate(oldObj.(*Workflow), w)...)
+
+	return nil
+}
+
+func (v *workflowValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	w := obj.(*Workflow)
+	if err := w.ValidateDelete(); err != nil {
+		return err
+	}
+
+	forced := !w.isDeletable() && w.isForceDeleted()
+	if forced {
+		username := "unknown"
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			username = req.UserInfo.Username
+		}
+
+		workflowlog.Info("Forced deletion of workflow", "name", w.Name, "namespace", w.Namespace, "state", w.Status.State, "user", username)
+	}
+
+	writeAuditRecords(ctx, w, audit.Record{Action: audit.ActionDelete, Forced: forced})
+
+	return nil
+}
+
+// auditUpdate returns the audit records for the changes to the workflow's desired state, hurry
+// flag, and drivers
+func auditUpdate(oldWorkflow *Workflow, w *Workflow) []audit.Record {
+	records := []audit.Record{}
+
+	if w.Spec.DesiredState != oldWorkflow.Spec.DesiredState {
+		records = append(records, audit.Record{Action: audit.ActionDesiredStateChange, From: string(oldWorkflow.Spec.DesiredState), To: string(w.Spec.DesiredState)})
+	}
+
+	if w.Spec.Hurry != oldWorkflow.Spec.Hurry {
+		records = append(records, audit.Record{Action: audit.ActionHurryChange, From: fmt.Sprint(oldWorkflow.Spec.Hurry), To: fmt.Sprint(w.Spec.Hurry)})
+	}
+
+	// The driver entries are only compared once they're registered
+	if len(w.Status.Drivers) != len(oldWorkflow.Status.Drivers) {
+		return records
+	}
+
+	for i := range w.Status.Drivers {
+		driver := &w.Status.Drivers[i]
+		oldDriver := &oldWorkflow.Status.Drivers[i]
+
+		record := audit.Record{DriverID: driver.DriverID, DWDIndex: &driver.DWDIndex, WatchState: string(driver.WatchState)}
+		switch {
+		case driver.Completed && !oldDriver.Completed:
+			record.Action = audit.ActionDriverCompleted
+		case driver.Status == StatusError && (oldDriver.Status != StatusError || !reflect.DeepEqual(driver.Error, oldDriver.Error)):
+			record.Action = audit.ActionDriverError
+			if driver.Error != nil {
+				record.Error = driver.Error.Error()
+			}
+		default:
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// writeAuditRecords fills in the admission request and the workflow for each record and writes
+// them to the audit log. Each record is marked as admitted, since the API server hasn't yet stored
+// the change. Dry runs aren't audited because they change nothing.
+func writeAuditRecords(ctx context.Context, w *Workflow, records ...audit.Record) {
+	if auditLog == nil || len(records) == 0 {
+		return
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		workflowlog.Error(err, "unable to audit workflow change without the admission request", "name", w.Name, "namespace", w.Namespace)
+		return
+	}
+
+	if req.DryRun != nil && *req.DryRun {
+		return
+	}
+
+	for i := range records {
+		records[i].Decision = audit.DecisionAdmitted
+		records[i].RequestUID = string(req.UID)
+		records[i].User = req.UserInfo.Username
+		records[i].Groups = req.UserInfo.Groups
+		records[i].Namespace = w.Namespace
+		records[i].Name = w.Name
+		records[i].WLMID = w.Spec.WLMID
+		records[i].JobID = w.Spec.JobID.String()
+		records[i].State = string(w.Status.State)
+	}
+
+	if err := auditLog.Log(records...); err != nil {
+		workflowlog.Error(err, "unable to write audit records", "name", w.Name, "namespace", w.Namespace)
+	}
+}
+
+// isDriverCancellation returns true if the only change to the driver entry is the workflow
+// controller cancelling it as the workflow moves to Teardown
+func isDriverCancellation(workflow *Workflow, oldDriver WorkflowDriverStatus, newDriver WorkflowDriverStatus) bool {
+	if workflow.Status.State != StateTeardown || newDriver.Status != StatusCancelled || newDriver.CancelTime == nil {
+		return false
+	}
+
+	oldDriver.Status = newDriver.Status
+	oldDriver.CancelTime = newDriver.CancelTime
+
+	return reflect.DeepEqual(oldDriver, newDriver)
+}
+
+func validateWorkflowImmutable(newWorkflow *Workflow, oldWorkflow *Workflow) error {
+
+	immutableError := func(childField string) error {
+		return field.Forbidden(field.NewPath("Spec").Child(childField), "field is immutable")
+	}
+
+	if newWorkflow.Spec.WLMID != oldWorkflow.Spec.WLMID {
+		return immutableError("WLMID")
+	}
+
+	if newWorkflow.Spec.JobID != oldWorkflow.Spec.JobID {
+		return immutableError("JobID")
+	}
+
+	if newWorkflow.Spec.UserID != oldWorkflow.Spec.UserID {
+		return immutableError("UserID")
+	}
+
+	if newWorkflow.Spec.GroupID != oldWorkflow.Spec.GroupID {
+		return immutableError("GroupID")
+	}
+
+	if !reflect.DeepEqual(newWorkflow.Spec.DWDirectives, oldWorkflow.Spec.DWDirectives) {
+		return immutableError("DWDirectives")
+	}
+
+	if !reflect.DeepEqual(newWorkflow.Spec.Predecessor, oldWorkflow.Spec.Predecessor) {
+		return immutableError("Predecessor")
+	}
+
+	// The index labels may be added to a workflow that doesn't have them, but not changed
+	for label := range workflowIndexLabels(oldWorkflow) {
+		if value, found := oldWorkflow.GetLabels()[label]; found && newWorkflow.GetLabels()[label] != value {
+			return field.Forbidden(field.NewPath("Metadata").Child("Labels").Key(label), "label is immutable")
+		}
+	}
+
+	return nil
+}
+
+// validateStateTimeouts checks that each state timeout refers to a valid state and has a
+// positive duration
+func validateStateTimeouts(workflow *Workflow) error {
+	timeoutsPath := field.NewPath("Spec").Child("StateTimeouts")
+
+	for state, timeout := range workflow.Spec.StateTimeouts {
+		if state != StateProposal && !state.after(StateProposal) {
+			return field.NotSupported(timeoutsPath, state, []string{
+				string(StateProposal), string(StateSetup), string(StateDataIn), string(StatePreRun),
+				string(StatePostRun), string(StateDataOut), string(StateTeardown),
+			})
+		}
+
+		if timeout.Duration <= 0 {
+			return field.Invalid(timeoutsPath.Key(string(state)), timeout.Duration.String(), "timeout must be greater than zero")
+		}
+	}
+
+	return nil
+}
+
+// validateDriverDependencies checks that the priorities and dependencies of the registered
+// drivers don't form a cycle, which would leave the drivers in the cycle waiting forever
+func validateDriverDependencies(workflow *Workflow) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	drivers := workflow.Status.Drivers
+	marks := make([]int, len(drivers))
+
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		marks[i] = visiting
+		for j := range drivers {
+			if !drivers[i].WaitsFor(&drivers[j]) {
+				continue
+			}
+
+			if marks[j] == visiting || (marks[j] == unvisited && !visit(j)) {
+				return false
+			}
+		}
+		marks[i] = visited
+
+		return true
+	}
+
+	for i := range drivers {
+		if marks[i] == unvisited && !visit(i) {
+			return field.Invalid(field.NewPath("Status").Child("Drivers").Index(i).Child("DependsOn"), drivers[i].DependsOn,
+				fmt.Sprintf("driver %s has a circular dependency in state %s", drivers[i].DriverID, drivers[i].WatchState))
+		}
+	}
+
+	return nil
+}
+
+// validateEnvVars checks that each environment variable is only set once
+func validateEnvVars(workflow *Workflow) error {
+	envVarsPath := field.NewPath("Status").Child("EnvVars")
+
+	sources := map[string]WorkflowEnvVar{}
+	for i, envVar := range workflow.Status.EnvVars {
+		if other, found := sources[envVar.Name]; found {
+			s := fmt.Sprintf("environment variable set by driver %s for DW Directive %d was already set by driver %s for DW Directive %d",
+				envVar.DriverID, envVar.DWDIndex, other.DriverID, other.DWDIndex)
+			return field.Invalid(envVarsPath.Index(i).Child("Name"), envVar.Name, s)
+		}
+
+		sources[envVar.Name] = envVar
+	}
+
+	return nil
+}
+
+// validatePredecessor checks that the predecessor of a new workflow can hand off the requested
+// allocations. The predecessor must be an existing workflow in the same namespace that belongs to
+// the same user and group and hasn't started Teardown. Each requested directive must be a jobdw
+// directive that isn't already being handed to another workflow.
+func validatePredecessor(workflow *Workflow) error {
+	if workflow.Spec.Predecessor == nil {
+		return nil
+	}
+
+	predecessorPath := field.NewPath("Spec").Child("Predecessor")
+	name := workflow.Spec.Predecessor.Name
+
+	if name == workflow.Name {
+		return field.Invalid(predecessorPath.Child("Name"), name, "a workflow cannot be its own predecessor")
+	}
+
+	predecessor := &Workflow{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: workflow.Namespace}, predecessor); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.NotFound(predecessorPath.Child("Name"), name)
+		}
+
+		return err
+	}
+
+	if predecessor.Spec.UserID != workflow.Spec.UserID || predecessor.Spec.GroupID != workflow.Spec.GroupID {
+		return field.Forbidden(predecessorPath.Child("Name"), "the predecessor must belong to the same user and group")
+	}
+
+	if !predecessor.GetDeletionTimestamp().IsZero() || predecessor.Spec.DesiredState == StateTeardown || predecessor.Status.State == StateTeardown {
+		return field.Invalid(predecessorPath.Child("Name"), name, fmt.Sprintf("the predecessor has already started %s", StateTeardown))
+	}
+
+	workflows := &WorkflowList{}
+	if err := c.List(context.TODO(), workflows, client.InNamespace(workflow.Namespace)); err != nil {
+		return err
+	}
+
+	indexes := map[int]bool{}
+	for i, dwdIndex := range workflow.Spec.Predecessor.DWDIndexes {
+		indexPath := predecessorPath.Child("DWDIndexes").Index(i)
+
+		if indexes[dwdIndex] {
+			return field.Duplicate(indexPath, dwdIndex)
+		}
+		indexes[dwdIndex] = true
+
+		if dwdIndex < 0 || dwdIndex >= len(predecessor.Spec.DWDirectives) {
+			return field.Invalid(indexPath, dwdIndex, "the predecessor has no directive at the index")
+		}
+
+		args, err := dwdparse.BuildArgsMap(predecessor.Spec.DWDirectives[dwdIndex])
+		if err != nil || args["command"] != "jobdw" {
+			return field.Invalid(indexPath, dwdIndex, "only the allocations of jobdw directives may be handed off")
+		}
+
+		for _, other := range workflows.Items {
+			if other.Name == workflow.Name || other.Spec.Predecessor == nil || other.Spec.Predecessor.Name != name || !other.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+
+			for _, otherIndex := range other.Spec.Predecessor.DWDIndexes {
+				if otherIndex == dwdIndex {
+					return field.Invalid(indexPath, dwdIndex, fmt.Sprintf("the allocation is already handed off to workflow %s", other.Name))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateHandoffs checks that handoff entries are only added, and that a handoff doesn't change
+// once it has completed or failed
+func validateHandoffs(newWorkflow *Workflow, oldWorkflow *Workflow) error {
+	handoffsPath := field.NewPath("Status").Child("Handoffs")
+
+	for _, oldHandoff := range oldWorkflow.Status.Handoffs {
+		handoff := newWorkflow.Status.FindHandoff(oldHandoff.Predecessor, oldHandoff.DWDIndex, oldHandoff.Successor)
+		if handoff == nil {
+			return field.Forbidden(handoffsPath, "handoff entries cannot be removed")
+		}
+
+		if oldHandoff.Status != StatusPending && !reflect.DeepEqual(*handoff, oldHandoff) {
+			return field.Forbidden(handoffsPath, fmt.Sprintf("handoff of DW Directive %d from workflow %s cannot change once it is %s", oldHandoff.DWDIndex, oldHandoff.Predecessor, oldHandoff.Status))
+		}
+	}
+
+	return nil
+}
+
+// validateStateHistory checks that state history entries are only appended. The entry for the
+// current state is filled in by the workflow controller when the state becomes ready, so it may
+// change as long as it's still for the same state and transition.
+func validateStateHistory(newWorkflow *Workflow, oldWorkflow *Workflow) error {
+	historyPath := field.NewPath("Status").Child("StateHistory")
+
+	oldHistory := oldWorkflow.Status.StateHistory
+	newHistory := newWorkflow.Status.StateHistory
+	if len(newHistory) < len(oldHistory) {
+		return field.Forbidden(historyPath, "state history entries cannot be removed")
+	}
+
+	for i := range oldHistory {
+		if i == len(oldHistory)-1 {
+			if newHistory[i].State != oldHistory[i].State || !reflect.DeepEqual(newHistory[i].DesiredStateChange, oldHistory[i].DesiredStateChange) {
+				return field.Forbidden(historyPath.Index(i), "state history entries cannot be changed")
+			}
+
+			continue
+		}
+
+		if !reflect.DeepEqual(newHistory[i], oldHistory[i]) {
+			return field.Forbidden(historyPath.Index(i), "state history entries cannot be changed")
+		}
+	}
+
+	return nil
+}
+
+// checkQuotas checks that the new workflow doesn't exceed any of the WorkflowQuota limits. Only
+// the workflows with the same user, group, or WLM index label as the new workflow are counted,
+// and they're read from the API server so that workflows admitted moments earlier are included.
+// The quota is still best-effort: creates admitted at the same moment don't see each other, and
+// workflows are only counted once they carry the index labels.
+func checkQuotas(workflow *Workflow) error {
+	quotas := &WorkflowQuotaList{}
+	if err := c.List(context.TODO(), quotas); err != nil {
+		return err
+	}
+
+	if len(quotas.Items) == 0 {
+		return nil
+	}
+
+	// Only count the workflows for the limits that are set
+	userLimited, groupLimited, wlmLimited := false, false, false
+	for _, quota := range quotas.Items {
+		userLimited = userLimited || quota.Spec.MaxWorkflowsPerUser > 0
+		groupLimited = groupLimited || quota.Spec.MaxWorkflowsPerGroup > 0
+		wlmLimited = wlmLimited || quota.Spec.MaxWorkflowsPerWLM > 0
+	}
+
+	usage := &WorkflowUsage{
+		Users:  map[string]int{},
+		Groups: map[string]int{},
+		WLMs:   map[string]int{},
+	}
+
+	if userLimited {
+		userUsage, err := labelledWorkflowUsage(MatchingUser(workflow.Spec.UserID))
+		if err != nil {
+			return err
+		}
+
+		userID := strconv.FormatUint(uint64(workflow.Spec.UserID), 10)
+		usage.Users[userID] = userUsage.Users[userID]
+	}
+
+	if groupLimited {
+		groupUsage, err := labelledWorkflowUsage(MatchingGroup(workflow.Spec.GroupID))
+		if err != nil {
+			return err
+		}
+
+		groupID := strconv.FormatUint(uint64(workflow.Spec.GroupID), 10)
+		usage.Groups[groupID] = groupUsage.Groups[groupID]
+	}
+
+	if wlmLimited {
+		wlmUsage, err := labelledWorkflowUsage(MatchingWLM(workflow.Spec.WLMID))
+		if err != nil {
+			return err
+		}
+
+		usage.WLMs[workflow.Spec.WLMID] = wlmUsage.WLMs[workflow.Spec.WLMID]
+	}
+
+	for i := range quotas.Items {
+		if err := quotas.Items[i].Check(usage, workflow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// labelledWorkflowUsage counts the workflows with the labels. A label value may be shared by more
+// than one ID, so the caller picks out the count for the ID it's checking.
+func labelledWorkflowUsage(matchingLabels client.MatchingLabels) (*WorkflowUsage, error) {
+	workflows := &WorkflowList{}
+	if err := apiReader.List(context.TODO(), workflows, matchingLabels); err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowUsage(workflows.Items), nil
+}
+
+func checkDirectives(workflow *Workflow, ruleParser RuleParser) error {
+	// Ok if we don't have any DW directives, stop parsing.
+	if len(workflow.Spec.DWDirectives) == 0 {
+		return nil
+	}
+
+	if err := ruleParser.ReadRules(); err != nil {
+		return err
+	}
+
+	// The directives are validated against the dwdparse form of the rules
+	rules := ruleParser.GetRuleList()
+	parserRules := []dwdparse.DWDirectiveRuleSpec{}
+	ruleIndex := map[string]int{}
+	for i := range rules {
+		parserRules = append(parserRules, rules[i].ParserSpec())
+		ruleIndex[ruleKey(rules[i].Command, rules[i].DriverLabel)] = i
+	}
+
+	// Forward the rule and directive index to the rule parsers matched directive handling
+	onValidDirectiveFunc := func(index int, rule dwdparse.DWDirectiveRuleSpec) {
+		ruleParser.MatchedDirective(workflow, index, rules[ruleIndex[ruleKey(rule.Command, rule.DriverLabel)]])
+	}
+
+	return dwdparse.Validate(parserRules, workflow.Spec.DWDirectives, onValidDirectiveFunc)
+}
+
+// RuleParser defines the interface a rule parser must provide
+// +kubebuilder:object:generate=false
+type RuleParser interface {
+	ReadRules() error
+	GetRuleList() []DWDirectiveRuleSpec
+	MatchedDirective(*Workflow, int, DWDirectiveRuleSpec)
+}
+
+// RuleList contains the rules to be applied for a particular driver
+// +kubebuilder:object:generate=false
+type RuleList struct {
+	rules []DWDirectiveRuleSpec
+
+	// Client used to read the DWDirectiveRules. The webhook's client is used if this is nil.
+	reader client.Reader
+
+	// Name of the DWDirectiveRule for each rule, keyed by ruleKey()
+	ruleSets map[string]string
+}
+
+// ruleKey identifies a rule by its command and driver label
+func ruleKey(command string, driverLabel string) string {
+	return command + "/" + driverLabel
+}
+
+// ReadRules imports the RulesList into usable go structures.
+func (r *RuleList) ReadRules() error {
+	ruleSetList := &DWDirectiveRuleList{}
+	ns := client.InNamespace(os.Getenv("POD_NAMESPACE"))
+	listOpts := []client.ListOption{
+		// Use all the DWDirectiveRules in the namespace we're running in
+		ns,
+	}
+
+	reader := r.reader
+	if reader == nil {
+		reader = c
+	}
+
+	if err := reader.List(context.TODO(), ruleSetList, listOpts...); err != nil {
+		return err
+	}
+
+	if len(ruleSetList.Items) == 0 {
+		return fmt.Errorf("unable to find ruleset in namespace: %s", ns)
+	}
+
+	r.rules = []DWDirectiveRuleSpec{}
+	r.ruleSets = map[string]string{}
+	for _, ruleSet := range ruleSetList.Items {
+		for _, rule := range ruleSet.Spec {
+			if rule.DriverLabel == "" {
+				rule.DriverLabel = ruleSet.Name
+			}
+			r.rules = append(r.rules, rule)
+			r.ruleSets[ruleKey(rule.Command, rule.DriverLabel)] = ruleSet.Name
+		}
+	}
+
+	return nil
+}
+
+// GetRuleList returns the current rules
+func (r *RuleList) GetRuleList() []DWDirectiveRuleSpec {
+	return r.rules
+}
+
+// MutatingRuleParser implements the RuleParser interface.
+var _ RuleParser = &MutatingRuleParser{}
+
+// MutatingRuleParser provides the rulelist
+// +kubebuilder:object:generate=false
+type MutatingRuleParser struct {
+	RuleList
+
+	// Explain records how each directive matched the rules
+	Explain bool
+
+	explanations []WorkflowDirectiveExplanation
+}
+
+// MatchedDirective updates the driver status entries to indicate driver availability
+func (r *MutatingRuleParser) MatchedDirective(workflow *Workflow, index int, rule DWDirectiveRuleSpec) {
+	if r.Explain {
+		r.explanations = append(r.explanations, WorkflowDirectiveExplanation{
+			DWDIndex:    index,
+			Directive:   workflow.Spec.DWDirectives[index],
+			RuleSet:     r.ruleSets[ruleKey(rule.Command, rule.DriverLabel)],
+			Command:     rule.Command,
+			DriverID:    rule.DriverLabel,
+			WatchStates: rule.WatchStates,
+		})
+	}
+
+	if len(rule.WatchStates) == 0 {
+		// Nothing to do
+		return
+	}
+
+	registrationMap := make(map[WorkflowState]bool)
+	// Build a map of previously registered states for this driver
+	for _, s := range workflow.Status.Drivers {
+		if s.DWDIndex != index {
+			continue
+		}
+
+		if s.DriverID != rule.DriverLabel {
+			continue
+		}
+
+		registrationMap[s.WatchState] = true
+	}
+
+	// Update driver status entries to indicate driver availability
+	for _, state := range rule.WatchStates {
+		// If this driver is already registered for this directive, skip it
+		if registrationMap[state] {
+			continue
+		}
+
+		// Register states for this driver
+		driverStatus := WorkflowDriverStatus{
+			DriverID:   rule.DriverLabel,
+			DWDIndex:   index,
+			WatchState: state,
+			Status:     StatusPending,
+			Priority:   rule.Priority,
+			DependsOn:  rule.DependsOn,
+		}
+		workflow.Status.Drivers = append(workflow.Status.Drivers, driverStatus)
+		workflowlog.Info("Registering driver", "Driver", driverStatus.DriverID, "Watch state", state)
+	}
+}
+
+// ValidatingRuleParser implements the RuleParser interface.
+var _ RuleParser = &ValidatingRuleParser{}
+
+// ValidatingRuleParser provides the rulelist
+// +kubebuilder:object:generate=false
+type ValidatingRuleParser struct {
+	RuleList
+}
+
+// MatchedDirective provides the interface function for the validating webhook
+func (r *ValidatingRuleParser) MatchedDirective(workflow *Workflow, index int, rule DWDirectiveRuleSpec) {
+}
@@ -0,0 +1,470 @@
+/*
+ * Copyright 2021, 2022 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/HewlettPackard/dws/utils/audit"
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// These tests are written in BDD-style using Ginkgo framework. Refer to
+// http://onsi.github.io/ginkgo to learn more.
+
+var _ = Describe("Workflow Webhook", func() {
+	var (
+		workflow *Workflow
+	)
+
+	BeforeEach(func() {
+		wfid := uuid.NewString()[0:8]
+		workflow = &Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("w%s", wfid),
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: WorkflowSpec{
+				DesiredState: StateProposal,
+				DWDirectives: []string{},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		if workflow != nil {
+			// Drop any local changes left by a rejected update
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(workflow), workflow)).To(Succeed())
+			metav1.SetMetaDataAnnotation(&workflow.ObjectMeta, WorkflowForceDeleteAnnotation, "true")
+			Expect(k8sClient.Update(context.TODO(), workflow)).To(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), workflow)).To(Succeed())
+		}
+	})
+
+	It("Fails to delete workflow before teardown unless forced", func() {
+		Expect(k8sClient.Create(context.TODO(), workflow)).To(Succeed())
+		Expect(k8sClient.Delete(context.TODO(), workflow)).ShouldNot(Succeed())
+
+		metav1.SetMetaDataAnnotation(&workflow.ObjectMeta, WorkflowForceDeleteAnnotation, "true")
+		Expect(k8sClient.Update(context.TODO(), workflow)).To(Succeed())
+		Expect(k8sClient.Delete(context.TODO(), workflow)).To(Succeed())
+		workflow = nil
+	})
+
+	It("Writes audit records for the workflow changes", func() {
+		Expect(k8sClient.Create(context.TODO(), workflow)).To(Succeed())
+
+		workflow.Spec.DesiredState = StateTeardown
+		workflow.Spec.Hurry = true
+		Expect(k8sClient.Update(context.TODO(), workflow)).To(Succeed())
+
+		// The hurry flag allows the delete without forcing it
+		Expect(k8sClient.Delete(context.TODO(), workflow)).To(Succeed())
+
+		records := []audit.Record{}
+		scanner := bufio.NewScanner(bytes.NewReader(auditBuffer.Bytes()))
+		for scanner.Scan() {
+			record := audit.Record{}
+			Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+			if record.Name == workflow.Name {
+				Expect(record.User).ToNot(BeEmpty())
+				records = append(records, record)
+			}
+		}
+
+		Expect(records).To(HaveLen(4))
+		for _, record := range records {
+			Expect(record.Decision).To(Equal(audit.DecisionAdmitted))
+			Expect(record.RequestUID).ToNot(BeEmpty())
+		}
+		Expect(records[0].Action).To(Equal(audit.ActionCreate))
+		Expect(records[1].Action).To(Equal(audit.ActionDesiredStateChange))
+		Expect(records[1].From).To(Equal(string(StateProposal)))
+		Expect(records[1].To).To(Equal(string(StateTeardown)))
+		Expect(records[2].Action).To(Equal(audit.ActionHurryChange))
+		Expect(records[2].To).To(Equal("true"))
+		Expect(records[3].Action).To(Equal(audit.ActionDelete))
+		Expect(records[3].Forced).To(BeFalse())
+		workflow = nil
+	})
+
+	It("Explains the directives on a server-side dry-run create", func() {
+		ruleSet := &DWDirectiveRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "explain-" + workflow.Name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: []DWDirectiveRuleSpec{
+				{
+					Command:     "explain",
+					DriverLabel: "explain-driver",
+					WatchStates: []WorkflowState{StateSetup, StateTeardown},
+					RuleDefs: []dwdparse.DWDirectiveRuleDef{
+						{Key: "name", Type: "string", IsRequired: true},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), ruleSet)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed()) })
+
+		workflow.Spec.DWDirectives = []string{"#DW explain name=test"}
+		// The webhook reads the rules from its cache, so give it time to see the new one
+		Eventually(func() error {
+			return k8sClient.Create(context.TODO(), workflow.DeepCopy(), client.DryRunAll)
+		}).Should(Succeed())
+		Expect(k8sClient.Create(context.TODO(), workflow, client.DryRunAll)).To(Succeed())
+		Expect(workflow.GetAnnotations()).To(HaveKey(WorkflowExplanationAnnotation))
+
+		explanation := WorkflowExplanation{}
+		Expect(json.Unmarshal([]byte(workflow.GetAnnotations()[WorkflowExplanationAnnotation]), &explanation)).To(Succeed())
+		Expect(explanation.Directives).To(ConsistOf(WorkflowDirectiveExplanation{
+			DWDIndex:    0,
+			Directive:   "#DW explain name=test",
+			RuleSet:     ruleSet.Name,
+			Command:     "explain",
+			DriverID:    "explain-driver",
+			WatchStates: []WorkflowState{StateSetup, StateTeardown},
+		}))
+		Expect(explanation.Drivers).To(ConsistOf(
+			WorkflowDriverStatus{DriverID: "explain-driver", DWDIndex: 0, WatchState: StateSetup, Status: StatusPending},
+			WorkflowDriverStatus{DriverID: "explain-driver", DWDIndex: 0, WatchState: StateTeardown, Status: StatusPending},
+		))
+		Expect(explanation.Env).To(Equal(map[string]string{
+			"DW_WORKFLOW_NAME":      workflow.Name,
+			"DW_WORKFLOW_NAMESPACE": workflow.Namespace,
+		}))
+
+		// The workflow itself isn't registered with the drivers
+		Expect(workflow.Status.Drivers).To(BeEmpty())
+
+		// Nothing is stored by a dry-run
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(workflow), &Workflow{})).ShouldNot(Succeed())
+		workflow = nil
+	})
+
+	It("Fails to create a workflow whose drivers depend on each other", func() {
+		ruleSet := &DWDirectiveRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cycle-" + workflow.Name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: []DWDirectiveRuleSpec{
+				{
+					Command:     "cycle",
+					DriverLabel: "cycle-driver-a",
+					WatchStates: []WorkflowState{StateSetup},
+					DependsOn:   []string{"cycle-driver-b"},
+					RuleDefs:    []dwdparse.DWDirectiveRuleDef{{Key: "name", Type: "string", IsRequired: true}},
+				},
+				{
+					Command:     "cycle",
+					DriverLabel: "cycle-driver-b",
+					WatchStates: []WorkflowState{StateSetup},
+					DependsOn:   []string{"cycle-driver-a"},
+					RuleDefs:    []dwdparse.DWDirectiveRuleDef{{Key: "name", Type: "string", IsRequired: true}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), ruleSet)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed()) })
+
+		workflow.Spec.DWDirectives = []string{"#DW cycle name=test"}
+		// Wait for the webhook to see the new rules, after which the workflow is rejected for
+		// the cycle instead of the unmatched directive
+		Eventually(func() string {
+			err := k8sClient.Create(context.TODO(), workflow.DeepCopy(), client.DryRunAll)
+			if err == nil {
+				return ""
+			}
+			return err.Error()
+		}).Should(ContainSubstring("circular dependency"))
+		Expect(k8sClient.Create(context.TODO(), workflow)).ToNot(Succeed())
+		workflow = nil
+	})
+
+	It("Validates the predecessor of a chained workflow", func() {
+		ruleSet := &DWDirectiveRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "chain-" + workflow.Name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: []DWDirectiveRuleSpec{
+				{Command: "jobdw", RuleDefs: []dwdparse.DWDirectiveRuleDef{{Key: "name", Type: "string", IsRequired: true}}},
+				{Command: "persistentdw", RuleDefs: []dwdparse.DWDirectiveRuleDef{{Key: "name", Type: "string", IsRequired: true}}},
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), ruleSet)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed()) })
+
+		workflow.Spec.DWDirectives = []string{"#DW jobdw name=scratch", "#DW persistentdw name=shared"}
+		Eventually(func() error {
+			return k8sClient.Create(context.TODO(), workflow)
+		}).Should(Succeed())
+
+		newSuccessor := func(name string, dwdIndexes ...int) *Workflow {
+			return &Workflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workflow.Name + "-" + name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: WorkflowSpec{
+					DesiredState: StateProposal,
+					DWDirectives: []string{},
+					Predecessor:  &WorkflowPredecessor{Name: workflow.Name, DWDIndexes: dwdIndexes},
+				},
+			}
+		}
+
+		successor := newSuccessor("a", 0)
+		Eventually(func() error {
+			return k8sClient.Create(context.TODO(), successor)
+		}).Should(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+			metav1.SetMetaDataAnnotation(&successor.ObjectMeta, WorkflowForceDeleteAnnotation, "true")
+			Expect(k8sClient.Update(context.TODO(), successor)).To(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), successor)).To(Succeed())
+		})
+
+		By("Rejecting an allocation that is already handed off")
+		// The webhook lists the workflows from its cache, so check with a dry run until it sees the first successor
+		Eventually(func() error {
+			return k8sClient.Create(context.TODO(), newSuccessor("b", 0), client.DryRunAll)
+		}).ShouldNot(Succeed())
+
+		By("Rejecting a directive that isn't jobdw")
+		Expect(k8sClient.Create(context.TODO(), newSuccessor("c", 1))).ShouldNot(Succeed())
+
+		By("Rejecting a directive index that's out of range")
+		Expect(k8sClient.Create(context.TODO(), newSuccessor("d", 2))).ShouldNot(Succeed())
+
+		By("Rejecting a predecessor that doesn't exist")
+		missing := newSuccessor("e", 0)
+		missing.Spec.Predecessor.Name = "missing"
+		Expect(k8sClient.Create(context.TODO(), missing)).ShouldNot(Succeed())
+
+		By("Rejecting a predecessor that belongs to another user")
+		otherUser := newSuccessor("f", 0)
+		otherUser.Spec.UserID = 1001
+		Expect(k8sClient.Create(context.TODO(), otherUser)).ShouldNot(Succeed())
+
+		By("Rejecting a change to the predecessor")
+		successor.Spec.Predecessor.DWDIndexes = []int{0, 1}
+		Expect(k8sClient.Update(context.TODO(), successor)).ShouldNot(Succeed())
+	})
+
+	It("Adds the index labels for the WLM, job, and user", func() {
+		workflow.Spec.WLMID = "slurm-a"
+		workflow.Spec.JobID = intstr.FromString("wlm job " + workflow.Name)
+		workflow.Spec.UserID = 1001
+		workflow.Spec.GroupID = 1002
+		Expect(k8sClient.Create(context.TODO(), workflow)).To(Succeed())
+
+		labels := workflow.GetLabels()
+		Expect(labels).To(HaveKeyWithValue(WorkflowWLMIDLabel, "slurm-a"))
+		Expect(labels).To(HaveKeyWithValue(WorkflowJobIDLabel, IndexLabelValue(workflow.Spec.JobID.String())))
+		Expect(labels).To(HaveKeyWithValue(WorkflowUserIDLabel, "1001"))
+		Expect(labels).To(HaveKeyWithValue(WorkflowGroupIDLabel, "1002"))
+
+		workflows := &WorkflowList{}
+		Expect(k8sClient.List(context.TODO(), workflows, MatchingJob("slurm-a", workflow.Spec.JobID))).To(Succeed())
+		Expect(workflows.Items).To(HaveLen(1))
+		Expect(workflows.Items[0].Name).To(Equal(workflow.Name))
+
+		Expect(k8sClient.List(context.TODO(), workflows, MatchingUser(1001), MatchingGroup(1002))).To(Succeed())
+		Expect(workflows.Items).ToNot(BeEmpty())
+
+		By("Rejecting a change to an index label")
+		labels[WorkflowUserIDLabel] = "0"
+		workflow.SetLabels(labels)
+		Expect(k8sClient.Update(context.TODO(), workflow)).ShouldNot(Succeed())
+	})
+
+	It("Fails to create workflow with hurry flag set", func() {
+		workflow.Spec.Hurry = true
+		Expect(k8sClient.Create(context.TODO(), workflow)).ShouldNot(Succeed())
+		workflow = nil
+	})
+
+	DescribeTable("Fails to create workflow with invalid state timeouts",
+		func(state WorkflowState, timeout time.Duration) {
+			workflow.Spec.StateTimeouts = map[WorkflowState]metav1.Duration{
+				state: {Duration: timeout},
+			}
+			Expect(k8sClient.Create(context.TODO(), workflow)).ShouldNot(Succeed())
+			workflow = nil
+		},
+		Entry("When the state is unknown", WorkflowState("Bogus"), time.Minute),
+		Entry("When the timeout is zero", StateSetup, time.Duration(0)),
+		Entry("When the timeout is negative", StateDataIn, -time.Minute),
+	)
+
+	DescribeTable("Workflow created only when Spec.DesiredState is Proposal",
+		func(desiredState WorkflowState, expectSuccess bool) {
+			workflow.Spec.DesiredState = desiredState
+			if expectSuccess {
+				Expect(k8sClient.Create(context.TODO(), workflow)).Should(Succeed())
+			} else {
+				Expect(k8sClient.Create(context.TODO(), workflow)).ShouldNot(Succeed())
+			}
+
+			workflow = nil
+		},
+		Entry("When Spec.DesiredState Proposal", StateProposal, true),
+		Entry("When Spec.DesiredState Setup", StateSetup, false),
+		Entry("When Spec.DesiredState DataIn", StateDataIn, false),
+		Entry("When Spec.DesiredState PreRun", StatePreRun, false),
+		Entry("When Spec.DesiredState PostRun", StatePostRun, false),
+		Entry("When Spec.DesiredState DataOut", StateDataOut, false),
+		Entry("When Spec.DesiredState Teardown", StateTeardown, false),
+	)
+
+	DescribeTable("Ignores Status.State on create",
+		func(statusState WorkflowState) {
+			workflow.Status.State = statusState
+			Expect(k8sClient.Create(context.TODO(), workflow)).Should(Succeed())
+			Expect(workflow.Status.State).To(BeEmpty())
+		},
+		Entry("When Status.State Proposal", StateProposal),
+		Entry("When Status.State Setup", StateSetup),
+		Entry("When Status.State DataIn", StateDataIn),
+		Entry("When Status.State PreRun", StatePreRun),
+		Entry("When Status.State PostRun", StatePostRun),
+		Entry("When Status.State DataOut", StateDataOut),
+		Entry("When Status.State Teardown", StateTeardown),
+	)
+
+	Describe("Invalid transitions after create", Ordered, func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(context.TODO(), workflow)).Should(Succeed())
+		})
+
+		DescribeTable("Fails to transition out of proposal", func(desiredState WorkflowState) {
+			workflow.Spec.DesiredState = desiredState
+			Expect(k8sClient.Update(context.TODO(), workflow)).ShouldNot(Succeed())
+		},
+			Entry("When Spec.DesiredState Setup", StateSetup), // This is a valid state transition, but status is not ready
+			Entry("When Spec.DesiredState DataIn", StateDataIn),
+			Entry("When Spec.DesiredState PreRun", StatePreRun),
+			Entry("When Spec.DesiredState PostRun", StatePostRun),
+			Entry("When Spec.DesiredState DataOut", StateDataOut),
+			//Entry("When Spec.DesiredState Teardown", StateTeardown), // Transition to Teardown is always permitted
+		)
+
+		DescribeTable("Fails to transition out of teardown", func(desiredState WorkflowState) {
+			workflow.Spec.DesiredState = StateTeardown
+			Expect(k8sClient.Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Spec.DesiredState = desiredState
+			Expect(k8sClient.Update(context.TODO(), workflow)).ShouldNot(Succeed())
+		},
+			Entry("When Spec.DesiredState Setup", StateSetup),
+			Entry("When Spec.DesiredState DataIn", StateDataIn),
+			Entry("When Spec.DesiredState PreRun", StatePreRun),
+			Entry("When Spec.DesiredState PostRun", StatePostRun),
+			Entry("When Spec.DesiredState DataOut", StateDataOut),
+		)
+
+		It("Fails to advance a suspended workflow except to teardown", func() {
+			workflow.Spec.Suspend = true
+			Expect(k8sClient.Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Spec.DesiredState = StateSetup
+			Expect(k8sClient.Update(context.TODO(), workflow)).ShouldNot(Succeed())
+
+			workflow.Spec.DesiredState = StateTeardown
+			Expect(k8sClient.Update(context.TODO(), workflow)).Should(Succeed())
+		})
+
+		It("Fails to set an environment variable that was set by another driver", func() {
+			workflow.Status.EnvVars = []WorkflowEnvVar{
+				{Name: "DW_JOB_STRIPED", Value: "/mnt/a", DriverID: "driver-a", DWDIndex: 0},
+				{Name: "DW_JOB_STRIPED", Value: "/mnt/b", DriverID: "driver-b", DWDIndex: 1},
+			}
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).ShouldNot(Succeed())
+		})
+
+		It("Validates driver entries on status updates", func() {
+			// Register a driver the way the workflow controller does
+			workflow.Status.State = StateProposal
+			workflow.Status.Drivers = []WorkflowDriverStatus{
+				{DriverID: "driver", WatchState: StateProposal, Status: StatusPending},
+			}
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Status.Drivers[0].Completed = true
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).ShouldNot(Succeed())
+
+			workflow.Status.Drivers[0].Status = StatusCompleted
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Status.Drivers = append(workflow.Status.Drivers, WorkflowDriverStatus{DriverID: "late", WatchState: StateSetup})
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).ShouldNot(Succeed())
+		})
+
+		It("Fails to change the state history", func() {
+			now := metav1.NowMicro()
+			workflow.Status.State = StateProposal
+			workflow.Status.StateHistory = []WorkflowStateHistory{
+				{State: StateProposal, DesiredStateChange: &now},
+			}
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).Should(Succeed())
+
+			// The entry for the current state is filled in when the state is ready
+			workflow.Status.StateHistory[0].ReadyChange = &now
+			workflow.Status.StateHistory[0].ElapsedTime = "1s"
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Status.StateHistory = append(workflow.Status.StateHistory, WorkflowStateHistory{State: StateTeardown, DesiredStateChange: &now})
+			Expect(k8sClient.Status().Update(context.TODO(), workflow)).Should(Succeed())
+
+			By("Changing an earlier entry")
+			changed := workflow.DeepCopy()
+			changed.Status.StateHistory[0].ElapsedTime = "2s"
+			Expect(k8sClient.Status().Update(context.TODO(), changed)).ShouldNot(Succeed())
+
+			By("Removing an entry")
+			removed := workflow.DeepCopy()
+			removed.Status.StateHistory = removed.Status.StateHistory[:1]
+			Expect(k8sClient.Status().Update(context.TODO(), removed)).ShouldNot(Succeed())
+		})
+
+		It("Fails to decrease the retry generation", func() {
+			workflow.Spec.RetryGeneration = 2
+			Expect(k8sClient.Update(context.TODO(), workflow)).Should(Succeed())
+
+			workflow.Spec.RetryGeneration = 1
+			Expect(k8sClient.Update(context.TODO(), workflow)).ShouldNot(Succeed())
+		})
+	})
+})
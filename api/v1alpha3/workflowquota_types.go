@@ -0,0 +1,175 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha3
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/HewlettPackard/dws/utils/updater"
+)
+
+// WorkflowQuotaSpec defines the limits on the number of concurrent workflows. A workflow
+// counts against the limits until its desiredState is Teardown.
+type WorkflowQuotaSpec struct {
+	// Maximum number of concurrent workflows for each spec.userID. Zero means no limit.
+	// +kubebuilder:validation:Minimum:=0
+	MaxWorkflowsPerUser int `json:"maxWorkflowsPerUser,omitempty"`
+
+	// Maximum number of concurrent workflows for each spec.groupID. Zero means no limit.
+	// +kubebuilder:validation:Minimum:=0
+	MaxWorkflowsPerGroup int `json:"maxWorkflowsPerGroup,omitempty"`
+
+	// Maximum number of concurrent workflows for each spec.wlmID. Zero means no limit.
+	// +kubebuilder:validation:Minimum:=0
+	MaxWorkflowsPerWLM int `json:"maxWorkflowsPerWLM,omitempty"`
+}
+
+// WorkflowQuotaUsage is the number of concurrent workflows for a single user, group, or WLM
+type WorkflowQuotaUsage struct {
+	// User ID, group ID, or WLM ID
+	ID string `json:"id"`
+
+	// Number of concurrent workflows
+	Workflows int `json:"workflows"`
+}
+
+// WorkflowQuotaStatus shows the current usage of the quota
+type WorkflowQuotaStatus struct {
+	// Concurrent workflows for each user ID
+	Users []WorkflowQuotaUsage `json:"users,omitempty"`
+
+	// Concurrent workflows for each group ID
+	Groups []WorkflowQuotaUsage `json:"groups,omitempty"`
+
+	// Concurrent workflows for each WLM ID
+	WLMs []WorkflowQuotaUsage `json:"wlms,omitempty"`
+
+	// Time the usage was last updated
+	LastUpdate *metav1.MicroTime `json:"lastUpdate,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:storageversion
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="PERUSER",type="integer",JSONPath=".spec.maxWorkflowsPerUser",description="Concurrent workflows allowed for each user"
+//+kubebuilder:printcolumn:name="PERGROUP",type="integer",JSONPath=".spec.maxWorkflowsPerGroup",description="Concurrent workflows allowed for each group"
+//+kubebuilder:printcolumn:name="PERWLM",type="integer",JSONPath=".spec.maxWorkflowsPerWLM",description="Concurrent workflows allowed for each WLM"
+//+kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// WorkflowQuota is the Schema for the workflowquotas API
+type WorkflowQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowQuotaSpec   `json:"spec,omitempty"`
+	Status WorkflowQuotaStatus `json:"status,omitempty"`
+}
+
+func (q *WorkflowQuota) GetStatus() updater.Status[*WorkflowQuotaStatus] {
+	return &q.Status
+}
+
+//+kubebuilder:object:root=true
+
+// WorkflowQuotaList contains a list of WorkflowQuota
+type WorkflowQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowQuota{}, &WorkflowQuotaList{})
+}
+
+// WorkflowUsage counts the concurrent workflows for each user, group, and WLM
+// +kubebuilder:object:generate=false
+type WorkflowUsage struct {
+	Users  map[string]int
+	Groups map[string]int
+	WLMs   map[string]int
+}
+
+// NewWorkflowUsage counts the workflows that are not being deleted and haven't been moved
+// to Teardown
+func NewWorkflowUsage(workflows []Workflow) *WorkflowUsage {
+	usage := &WorkflowUsage{
+		Users:  map[string]int{},
+		Groups: map[string]int{},
+		WLMs:   map[string]int{},
+	}
+
+	for _, workflow := range workflows {
+		if workflow.Spec.DesiredState == StateTeardown || !workflow.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		usage.Users[strconv.FormatUint(uint64(workflow.Spec.UserID), 10)]++
+		usage.Groups[strconv.FormatUint(uint64(workflow.Spec.GroupID), 10)]++
+		usage.WLMs[workflow.Spec.WLMID]++
+	}
+
+	return usage
+}
+
+// Check returns an error naming the limit of the quota that the workflow would exceed
+func (q *WorkflowQuota) Check(usage *WorkflowUsage, workflow *Workflow) error {
+	specPath := field.NewPath("Spec")
+
+	userID := strconv.FormatUint(uint64(workflow.Spec.UserID), 10)
+	if q.Spec.MaxWorkflowsPerUser > 0 && usage.Users[userID] >= q.Spec.MaxWorkflowsPerUser {
+		return field.Forbidden(specPath.Child("UserID"), fmt.Sprintf("user %s has reached the limit of %d concurrent workflows set by WorkflowQuota %s", userID, q.Spec.MaxWorkflowsPerUser, q.Name))
+	}
+
+	groupID := strconv.FormatUint(uint64(workflow.Spec.GroupID), 10)
+	if q.Spec.MaxWorkflowsPerGroup > 0 && usage.Groups[groupID] >= q.Spec.MaxWorkflowsPerGroup {
+		return field.Forbidden(specPath.Child("GroupID"), fmt.Sprintf("group %s has reached the limit of %d concurrent workflows set by WorkflowQuota %s", groupID, q.Spec.MaxWorkflowsPerGroup, q.Name))
+	}
+
+	if q.Spec.MaxWorkflowsPerWLM > 0 && usage.WLMs[workflow.Spec.WLMID] >= q.Spec.MaxWorkflowsPerWLM {
+		return field.Forbidden(specPath.Child("WLMID"), fmt.Sprintf("WLM %s has reached the limit of %d concurrent workflows set by WorkflowQuota %s", workflow.Spec.WLMID, q.Spec.MaxWorkflowsPerWLM, q.Name))
+	}
+
+	return nil
+}
+
+// SetUsage fills in the quota status from the workflow usage
+func (s *WorkflowQuotaStatus) SetUsage(usage *WorkflowUsage) {
+	toList := func(counts map[string]int) []WorkflowQuotaUsage {
+		var list []WorkflowQuotaUsage
+		for id, workflows := range counts {
+			list = append(list, WorkflowQuotaUsage{ID: id, Workflows: workflows})
+		}
+
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+		return list
+	}
+
+	s.Users = toList(usage.Users)
+	s.Groups = toList(usage.Groups)
+	s.WLMs = toList(usage.WLMs)
+}
@@ -0,0 +1,1912 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllocationSetColocationConstraint) DeepCopyInto(out *AllocationSetColocationConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationSetColocationConstraint.
+func (in *AllocationSetColocationConstraint) DeepCopy() *AllocationSetColocationConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationSetColocationConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllocationSetConstraints) DeepCopyInto(out *AllocationSetConstraints) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Colocation != nil {
+		in, out := &in.Colocation, &out.Colocation
+		*out = make([]AllocationSetColocationConstraint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationSetConstraints.
+func (in *AllocationSetConstraints) DeepCopy() *AllocationSetConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationSetConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMount) DeepCopyInto(out *ClientMount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMount.
+func (in *ClientMount) DeepCopy() *ClientMount {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientMount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountDevice) DeepCopyInto(out *ClientMountDevice) {
+	*out = *in
+	if in.Lustre != nil {
+		in, out := &in.Lustre, &out.Lustre
+		*out = new(ClientMountDeviceLustre)
+		**out = **in
+	}
+	if in.LVM != nil {
+		in, out := &in.LVM, &out.LVM
+		*out = new(ClientMountDeviceLVM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceReference != nil {
+		in, out := &in.DeviceReference, &out.DeviceReference
+		*out = new(ClientMountDeviceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountDevice.
+func (in *ClientMountDevice) DeepCopy() *ClientMountDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountDeviceLVM) DeepCopyInto(out *ClientMountDeviceLVM) {
+	*out = *in
+	if in.NVMeInfo != nil {
+		in, out := &in.NVMeInfo, &out.NVMeInfo
+		*out = make([]ClientMountNVMeDesc, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountDeviceLVM.
+func (in *ClientMountDeviceLVM) DeepCopy() *ClientMountDeviceLVM {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountDeviceLVM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountDeviceLustre) DeepCopyInto(out *ClientMountDeviceLustre) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountDeviceLustre.
+func (in *ClientMountDeviceLustre) DeepCopy() *ClientMountDeviceLustre {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountDeviceLustre)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountDeviceReference) DeepCopyInto(out *ClientMountDeviceReference) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountDeviceReference.
+func (in *ClientMountDeviceReference) DeepCopy() *ClientMountDeviceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountDeviceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountInfo) DeepCopyInto(out *ClientMountInfo) {
+	*out = *in
+	in.Device.DeepCopyInto(&out.Device)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountInfo.
+func (in *ClientMountInfo) DeepCopy() *ClientMountInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountInfoStatus) DeepCopyInto(out *ClientMountInfoStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountInfoStatus.
+func (in *ClientMountInfoStatus) DeepCopy() *ClientMountInfoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountInfoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountList) DeepCopyInto(out *ClientMountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountList.
+func (in *ClientMountList) DeepCopy() *ClientMountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientMountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountNVMeDesc) DeepCopyInto(out *ClientMountNVMeDesc) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountNVMeDesc.
+func (in *ClientMountNVMeDesc) DeepCopy() *ClientMountNVMeDesc {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountNVMeDesc)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountSpec) DeepCopyInto(out *ClientMountSpec) {
+	*out = *in
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]ClientMountInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountSpec.
+func (in *ClientMountSpec) DeepCopy() *ClientMountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMountStatus) DeepCopyInto(out *ClientMountStatus) {
+	*out = *in
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]ClientMountInfoStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ResourceError.DeepCopyInto(&out.ResourceError)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMountStatus.
+func (in *ClientMountStatus) DeepCopy() *ClientMountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeBreakdown) DeepCopyInto(out *ComputeBreakdown) {
+	*out = *in
+	in.Constraints.DeepCopyInto(&out.Constraints)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeBreakdown.
+func (in *ComputeBreakdown) DeepCopy() *ComputeBreakdown {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeBreakdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeConstraints) DeepCopyInto(out *ComputeConstraints) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]ComputeLocationConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeConstraints.
+func (in *ComputeConstraints) DeepCopy() *ComputeConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeLocationAccess) DeepCopyInto(out *ComputeLocationAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeLocationAccess.
+func (in *ComputeLocationAccess) DeepCopy() *ComputeLocationAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeLocationAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeLocationConstraint) DeepCopyInto(out *ComputeLocationConstraint) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]ComputeLocationAccess, len(*in))
+		copy(*out, *in)
+	}
+	out.Reference = in.Reference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeLocationConstraint.
+func (in *ComputeLocationConstraint) DeepCopy() *ComputeLocationConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeLocationConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Computes) DeepCopyInto(out *Computes) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Computes.
+func (in *Computes) DeepCopy() *Computes {
+	if in == nil {
+		return nil
+	}
+	out := new(Computes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Computes) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputesData) DeepCopyInto(out *ComputesData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputesData.
+func (in *ComputesData) DeepCopy() *ComputesData {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputesData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputesList) DeepCopyInto(out *ComputesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Computes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputesList.
+func (in *ComputesList) DeepCopy() *ComputesList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputesSpec) DeepCopyInto(out *ComputesSpec) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]ComputesData, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputesSpec.
+func (in *ComputesSpec) DeepCopy() *ComputesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputesStatus) DeepCopyInto(out *ComputesStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputesStatus.
+func (in *ComputesStatus) DeepCopy() *ComputesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DWDirectiveRule) DeepCopyInto(out *DWDirectiveRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = make([]DWDirectiveRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DWDirectiveRule.
+func (in *DWDirectiveRule) DeepCopy() *DWDirectiveRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DWDirectiveRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DWDirectiveRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DWDirectiveRuleList) DeepCopyInto(out *DWDirectiveRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DWDirectiveRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DWDirectiveRuleList.
+func (in *DWDirectiveRuleList) DeepCopy() *DWDirectiveRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(DWDirectiveRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DWDirectiveRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DWDirectiveRuleSpec) DeepCopyInto(out *DWDirectiveRuleSpec) {
+	*out = *in
+	if in.WatchStates != nil {
+		in, out := &in.WatchStates, &out.WatchStates
+		*out = make([]WorkflowState, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuleDefs != nil {
+		in, out := &in.RuleDefs, &out.RuleDefs
+		*out = make([]dwdparse.DWDirectiveRuleDef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DWDirectiveRuleSpec.
+func (in *DWDirectiveRuleSpec) DeepCopy() *DWDirectiveRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DWDirectiveRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectiveBreakdown) DeepCopyInto(out *DirectiveBreakdown) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectiveBreakdown.
+func (in *DirectiveBreakdown) DeepCopy() *DirectiveBreakdown {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectiveBreakdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectiveBreakdown) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectiveBreakdownList) DeepCopyInto(out *DirectiveBreakdownList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectiveBreakdown, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectiveBreakdownList.
+func (in *DirectiveBreakdownList) DeepCopy() *DirectiveBreakdownList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectiveBreakdownList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectiveBreakdownList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectiveBreakdownSpec) DeepCopyInto(out *DirectiveBreakdownSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectiveBreakdownSpec.
+func (in *DirectiveBreakdownSpec) DeepCopy() *DirectiveBreakdownSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectiveBreakdownSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectiveBreakdownStatus) DeepCopyInto(out *DirectiveBreakdownStatus) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageBreakdown)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compute != nil {
+		in, out := &in.Compute, &out.Compute
+		*out = new(ComputeBreakdown)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ResourceError.DeepCopyInto(&out.ResourceError)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectiveBreakdownStatus.
+func (in *DirectiveBreakdownStatus) DeepCopy() *DirectiveBreakdownStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectiveBreakdownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Node) DeepCopyInto(out *Node) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Node.
+func (in *Node) DeepCopy() *Node {
+	if in == nil {
+		return nil
+	}
+	out := new(Node)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentStorageInstance) DeepCopyInto(out *PersistentStorageInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentStorageInstance.
+func (in *PersistentStorageInstance) DeepCopy() *PersistentStorageInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentStorageInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentStorageInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentStorageInstanceList) DeepCopyInto(out *PersistentStorageInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PersistentStorageInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentStorageInstanceList.
+func (in *PersistentStorageInstanceList) DeepCopy() *PersistentStorageInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentStorageInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentStorageInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentStorageInstanceSpec) DeepCopyInto(out *PersistentStorageInstanceSpec) {
+	*out = *in
+	if in.ConsumerReferences != nil {
+		in, out := &in.ConsumerReferences, &out.ConsumerReferences
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentStorageInstanceSpec.
+func (in *PersistentStorageInstanceSpec) DeepCopy() *PersistentStorageInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentStorageInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentStorageInstanceStatus) DeepCopyInto(out *PersistentStorageInstanceStatus) {
+	*out = *in
+	out.Servers = in.Servers
+	in.ResourceError.DeepCopyInto(&out.ResourceError)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentStorageInstanceStatus.
+func (in *PersistentStorageInstanceStatus) DeepCopy() *PersistentStorageInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentStorageInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceError) DeepCopyInto(out *ResourceError) {
+	*out = *in
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(ResourceErrorInfo)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceError.
+func (in *ResourceError) DeepCopy() *ResourceError {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceErrorInfo) DeepCopyInto(out *ResourceErrorInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceErrorInfo.
+func (in *ResourceErrorInfo) DeepCopy() *ResourceErrorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceErrorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Servers) DeepCopyInto(out *Servers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Servers.
+func (in *Servers) DeepCopy() *Servers {
+	if in == nil {
+		return nil
+	}
+	out := new(Servers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Servers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersList) DeepCopyInto(out *ServersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Servers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersList.
+func (in *ServersList) DeepCopy() *ServersList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersSpec) DeepCopyInto(out *ServersSpec) {
+	*out = *in
+	if in.AllocationSets != nil {
+		in, out := &in.AllocationSets, &out.AllocationSets
+		*out = make([]ServersSpecAllocationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersSpec.
+func (in *ServersSpec) DeepCopy() *ServersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersSpecAllocationSet) DeepCopyInto(out *ServersSpecAllocationSet) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]ServersSpecStorage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersSpecAllocationSet.
+func (in *ServersSpecAllocationSet) DeepCopy() *ServersSpecAllocationSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersSpecAllocationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersSpecStorage) DeepCopyInto(out *ServersSpecStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersSpecStorage.
+func (in *ServersSpecStorage) DeepCopy() *ServersSpecStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersSpecStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersStatus) DeepCopyInto(out *ServersStatus) {
+	*out = *in
+	if in.LastUpdate != nil {
+		in, out := &in.LastUpdate, &out.LastUpdate
+		*out = (*in).DeepCopy()
+	}
+	if in.AllocationSets != nil {
+		in, out := &in.AllocationSets, &out.AllocationSets
+		*out = make([]ServersStatusAllocationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersStatus.
+func (in *ServersStatus) DeepCopy() *ServersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersStatusAllocationSet) DeepCopyInto(out *ServersStatusAllocationSet) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make(map[string]ServersStatusStorage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersStatusAllocationSet.
+func (in *ServersStatusAllocationSet) DeepCopy() *ServersStatusAllocationSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersStatusAllocationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersStatusStorage) DeepCopyInto(out *ServersStatusStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersStatusStorage.
+func (in *ServersStatusStorage) DeepCopy() *ServersStatusStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersStatusStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Storage) DeepCopyInto(out *Storage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
+func (in *Storage) DeepCopy() *Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Storage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageAccess) DeepCopyInto(out *StorageAccess) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]Node, len(*in))
+		copy(*out, *in)
+	}
+	if in.Computes != nil {
+		in, out := &in.Computes, &out.Computes
+		*out = make([]Node, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAccess.
+func (in *StorageAccess) DeepCopy() *StorageAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageAllocationSet) DeepCopyInto(out *StorageAllocationSet) {
+	*out = *in
+	in.Constraints.DeepCopyInto(&out.Constraints)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAllocationSet.
+func (in *StorageAllocationSet) DeepCopy() *StorageAllocationSet {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageAllocationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBreakdown) DeepCopyInto(out *StorageBreakdown) {
+	*out = *in
+	out.Reference = in.Reference
+	if in.AllocationSets != nil {
+		in, out := &in.AllocationSets, &out.AllocationSets
+		*out = make([]StorageAllocationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBreakdown.
+func (in *StorageBreakdown) DeepCopy() *StorageBreakdown {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageBreakdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageDevice) DeepCopyInto(out *StorageDevice) {
+	*out = *in
+	if in.WearLevel != nil {
+		in, out := &in.WearLevel, &out.WearLevel
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageDevice.
+func (in *StorageDevice) DeepCopy() *StorageDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageList) DeepCopyInto(out *StorageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Storage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageList.
+func (in *StorageList) DeepCopy() *StorageList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageSpec.
+func (in *StorageSpec) DeepCopy() *StorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageStatus) DeepCopyInto(out *StorageStatus) {
+	*out = *in
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]StorageDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Access.DeepCopyInto(&out.Access)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageStatus.
+func (in *StorageStatus) DeepCopy() *StorageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfiguration) DeepCopyInto(out *SystemConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfiguration.
+func (in *SystemConfiguration) DeepCopy() *SystemConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationComputeNode) DeepCopyInto(out *SystemConfigurationComputeNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationComputeNode.
+func (in *SystemConfigurationComputeNode) DeepCopy() *SystemConfigurationComputeNode {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationComputeNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationComputeNodeReference) DeepCopyInto(out *SystemConfigurationComputeNodeReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationComputeNodeReference.
+func (in *SystemConfigurationComputeNodeReference) DeepCopy() *SystemConfigurationComputeNodeReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationComputeNodeReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationList) DeepCopyInto(out *SystemConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SystemConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationList.
+func (in *SystemConfigurationList) DeepCopy() *SystemConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationSpec) DeepCopyInto(out *SystemConfigurationSpec) {
+	*out = *in
+	if in.ComputeNodes != nil {
+		in, out := &in.ComputeNodes, &out.ComputeNodes
+		*out = make([]SystemConfigurationComputeNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageNodes != nil {
+		in, out := &in.StorageNodes, &out.StorageNodes
+		*out = make([]SystemConfigurationStorageNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]intstr.IntOrString, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationSpec.
+func (in *SystemConfigurationSpec) DeepCopy() *SystemConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationStatus) DeepCopyInto(out *SystemConfigurationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationStatus.
+func (in *SystemConfigurationStatus) DeepCopy() *SystemConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfigurationStorageNode) DeepCopyInto(out *SystemConfigurationStorageNode) {
+	*out = *in
+	if in.ComputesAccess != nil {
+		in, out := &in.ComputesAccess, &out.ComputesAccess
+		*out = make([]SystemConfigurationComputeNodeReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfigurationStorageNode.
+func (in *SystemConfigurationStorageNode) DeepCopy() *SystemConfigurationStorageNode {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfigurationStorageNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workflow) DeepCopyInto(out *Workflow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workflow.
+func (in *Workflow) DeepCopy() *Workflow {
+	if in == nil {
+		return nil
+	}
+	out := new(Workflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Workflow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowAutoAdvance) DeepCopyInto(out *WorkflowAutoAdvance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowAutoAdvance.
+func (in *WorkflowAutoAdvance) DeepCopy() *WorkflowAutoAdvance {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowAutoAdvance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDirectiveExplanation) DeepCopyInto(out *WorkflowDirectiveExplanation) {
+	*out = *in
+	if in.WatchStates != nil {
+		in, out := &in.WatchStates, &out.WatchStates
+		*out = make([]WorkflowState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDirectiveExplanation.
+func (in *WorkflowDirectiveExplanation) DeepCopy() *WorkflowDirectiveExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDirectiveExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDirectiveSummary) DeepCopyInto(out *WorkflowDirectiveSummary) {
+	*out = *in
+	if in.DriverIDs != nil {
+		in, out := &in.DriverIDs, &out.DriverIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDirectiveSummary.
+func (in *WorkflowDirectiveSummary) DeepCopy() *WorkflowDirectiveSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDirectiveSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDriverCompletion) DeepCopyInto(out *WorkflowDriverCompletion) {
+	*out = *in
+	if in.CompleteTime != nil {
+		in, out := &in.CompleteTime, &out.CompleteTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDriverCompletion.
+func (in *WorkflowDriverCompletion) DeepCopy() *WorkflowDriverCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDriverCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDriverStatus) DeepCopyInto(out *WorkflowDriverStatus) {
+	*out = *in
+	if in.LastHB != nil {
+		in, out := &in.LastHB, &out.LastHB
+		*out = (*in).DeepCopy()
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(ResourceErrorInfo)
+		**out = **in
+	}
+	if in.CompleteTime != nil {
+		in, out := &in.CompleteTime, &out.CompleteTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CancelTime != nil {
+		in, out := &in.CancelTime, &out.CancelTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorTime != nil {
+		in, out := &in.ErrorTime, &out.ErrorTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDriverStatus.
+func (in *WorkflowDriverStatus) DeepCopy() *WorkflowDriverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDriverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEnvVar) DeepCopyInto(out *WorkflowEnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEnvVar.
+func (in *WorkflowEnvVar) DeepCopy() *WorkflowEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowExplanation) DeepCopyInto(out *WorkflowExplanation) {
+	*out = *in
+	if in.Directives != nil {
+		in, out := &in.Directives, &out.Directives
+		*out = make([]WorkflowDirectiveExplanation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]WorkflowDriverStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowExplanation.
+func (in *WorkflowExplanation) DeepCopy() *WorkflowExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowHandoff) DeepCopyInto(out *WorkflowHandoff) {
+	*out = *in
+	if in.CompleteTime != nil {
+		in, out := &in.CompleteTime, &out.CompleteTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowHandoff.
+func (in *WorkflowHandoff) DeepCopy() *WorkflowHandoff {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowHandoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowList) DeepCopyInto(out *WorkflowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Workflow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowList.
+func (in *WorkflowList) DeepCopy() *WorkflowList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPredecessor) DeepCopyInto(out *WorkflowPredecessor) {
+	*out = *in
+	if in.DWDIndexes != nil {
+		in, out := &in.DWDIndexes, &out.DWDIndexes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPredecessor.
+func (in *WorkflowPredecessor) DeepCopy() *WorkflowPredecessor {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPredecessor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowQuota) DeepCopyInto(out *WorkflowQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowQuota.
+func (in *WorkflowQuota) DeepCopy() *WorkflowQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowQuotaList) DeepCopyInto(out *WorkflowQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowQuotaList.
+func (in *WorkflowQuotaList) DeepCopy() *WorkflowQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowQuotaSpec) DeepCopyInto(out *WorkflowQuotaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowQuotaSpec.
+func (in *WorkflowQuotaSpec) DeepCopy() *WorkflowQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowQuotaStatus) DeepCopyInto(out *WorkflowQuotaStatus) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]WorkflowQuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]WorkflowQuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.WLMs != nil {
+		in, out := &in.WLMs, &out.WLMs
+		*out = make([]WorkflowQuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdate != nil {
+		in, out := &in.LastUpdate, &out.LastUpdate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowQuotaStatus.
+func (in *WorkflowQuotaStatus) DeepCopy() *WorkflowQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowQuotaUsage) DeepCopyInto(out *WorkflowQuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowQuotaUsage.
+func (in *WorkflowQuotaUsage) DeepCopy() *WorkflowQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
+	*out = *in
+	out.JobID = in.JobID
+	if in.DWDirectives != nil {
+		in, out := &in.DWDirectives, &out.DWDirectives
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateTimeouts != nil {
+		in, out := &in.StateTimeouts, &out.StateTimeouts
+		*out = make(map[WorkflowState]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AutoAdvance != nil {
+		in, out := &in.AutoAdvance, &out.AutoAdvance
+		*out = new(WorkflowAutoAdvance)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Predecessor != nil {
+		in, out := &in.Predecessor, &out.Predecessor
+		*out = new(WorkflowPredecessor)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
+func (in *WorkflowSpec) DeepCopy() *WorkflowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowStateHistory) DeepCopyInto(out *WorkflowStateHistory) {
+	*out = *in
+	if in.DesiredStateChange != nil {
+		in, out := &in.DesiredStateChange, &out.DesiredStateChange
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyChange != nil {
+		in, out := &in.ReadyChange, &out.ReadyChange
+		*out = (*in).DeepCopy()
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]WorkflowDriverCompletion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStateHistory.
+func (in *WorkflowStateHistory) DeepCopy() *WorkflowStateHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowStateHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
+	*out = *in
+	in.ResourceError.DeepCopyInto(&out.ResourceError)
+	if in.DirectiveSummaries != nil {
+		in, out := &in.DirectiveSummaries, &out.DirectiveSummaries
+		*out = make([]WorkflowDirectiveSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]WorkflowEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]WorkflowDriverStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DirectiveBreakdowns != nil {
+		in, out := &in.DirectiveBreakdowns, &out.DirectiveBreakdowns
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	out.Computes = in.Computes
+	if in.DesiredStateChange != nil {
+		in, out := &in.DesiredStateChange, &out.DesiredStateChange
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyChange != nil {
+		in, out := &in.ReadyChange, &out.ReadyChange
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryChange != nil {
+		in, out := &in.RetryChange, &out.RetryChange
+		*out = (*in).DeepCopy()
+	}
+	if in.SuspendChange != nil {
+		in, out := &in.SuspendChange, &out.SuspendChange
+		*out = (*in).DeepCopy()
+	}
+	if in.SuspendedTime != nil {
+		in, out := &in.SuspendedTime, &out.SuspendedTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StateHistory != nil {
+		in, out := &in.StateHistory, &out.StateHistory
+		*out = make([]WorkflowStateHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Handoffs != nil {
+		in, out := &in.Handoffs, &out.Handoffs
+		*out = make([]WorkflowHandoff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStatus.
+func (in *WorkflowStatus) DeepCopy() *WorkflowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -31,7 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	"github.com/HewlettPackard/dws/utils/updater"
 )
 
@@ -54,7 +54,7 @@ const (
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
-	clientMount := &dwsv1alpha2.ClientMount{}
+	clientMount := &dwsv1alpha3.ClientMount{}
 	if err := r.Get(ctx, req.NamespacedName, clientMount); err != nil {
 		// ignore not-found errors, since they can't be fixed by an immediate
 		// requeue (we'll need to wait for a new notification), and we can get them
@@ -64,7 +64,7 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Create a status updater that handles the call to r.Status().Update() if any of the fields
 	// in clientMount.Status{} change
-	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha2.ClientMountStatus](clientMount)
+	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha3.ClientMountStatus](clientMount)
 	defer func() { err = statusUpdater.CloseWithStatusUpdate(ctx, r.Client.Status(), err) }()
 
 	// Handle cleanup if the resource is being deleted
@@ -81,9 +81,12 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// Bring the conditions in line with the rest of the status before it's written
+	defer func() { clientMount.Status.SetConditions(clientMount.Generation) }()
+
 	// Create the status section if it doesn't exist yet
 	if len(clientMount.Status.Mounts) != len(clientMount.Spec.Mounts) {
-		clientMount.Status.Mounts = make([]dwsv1alpha2.ClientMountInfoStatus, len(clientMount.Spec.Mounts))
+		clientMount.Status.Mounts = make([]dwsv1alpha3.ClientMountInfoStatus, len(clientMount.Spec.Mounts))
 	}
 
 	// Initialize the status section if the desired state doesn't match the status state
@@ -124,7 +127,7 @@ func filterByComputeNamespacePrefix() predicate.Predicate {
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClientMountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&dwsv1alpha2.ClientMount{}).
+		For(&dwsv1alpha3.ClientMount{}).
 		WithEventFilter(filterByComputeNamespacePrefix()).
 		Complete(r)
 }
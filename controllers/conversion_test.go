@@ -33,8 +33,8 @@ import (
 
 	dwsv1alpha1 "github.com/HewlettPackard/dws/api/v1alpha1"
 	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	utilconversion "github.com/HewlettPackard/dws/github/cluster-api/util/conversion"
-	"github.com/HewlettPackard/dws/utils/dwdparse"
 )
 
 var _ = Describe("Conversion Webhook Test", func() {
@@ -48,24 +48,24 @@ var _ = Describe("Conversion Webhook Test", func() {
 	// have that annotation when it is accessed by its hub API.
 
 	Context("ClientMount", func() {
-		var resHub *dwsv1alpha2.ClientMount
+		var resHub *dwsv1alpha3.ClientMount
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.ClientMount{
+			resHub = &dwsv1alpha3.ClientMount{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.ClientMountSpec{
+				Spec: dwsv1alpha3.ClientMountSpec{
 					Node:         "client-01",
 					DesiredState: "unmounted",
-					Mounts: []dwsv1alpha2.ClientMountInfo{
+					Mounts: []dwsv1alpha3.ClientMountInfo{
 						{
 							MountPath:      "",
 							SetPermissions: false,
 							Options:        "",
-							Device: dwsv1alpha2.ClientMountDevice{
+							Device: dwsv1alpha3.ClientMountDevice{
 								Type: "reference",
 							},
 							Type:       "none",
@@ -81,14 +81,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.ClientMount{}
+				expected := &dwsv1alpha3.ClientMount{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads ClientMount resource via hub and via spoke", func() {
+		It("reads ClientMount resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.ClientMount{}
 			Eventually(func(g Gomega) {
@@ -98,6 +98,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.ClientMount{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -108,16 +117,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("DWDirectiveRule", func() {
-		var resHub *dwsv1alpha2.DWDirectiveRule
+		var resHub *dwsv1alpha3.DWDirectiveRule
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.DWDirectiveRule{
+			resHub = &dwsv1alpha3.DWDirectiveRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: []dwdparse.DWDirectiveRuleSpec{},
+				Spec: []dwsv1alpha3.DWDirectiveRuleSpec{},
 			}
 
 			Expect(k8sClient.Create(context.TODO(), resHub)).To(Succeed())
@@ -126,14 +135,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.DWDirectiveRule{}
+				expected := &dwsv1alpha3.DWDirectiveRule{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads DWDirectiveRule resource via hub and via spoke", func() {
+		It("reads DWDirectiveRule resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.DWDirectiveRule{}
 			Eventually(func(g Gomega) {
@@ -143,6 +152,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.DWDirectiveRule{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -153,16 +171,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("DirectiveBreakdown", func() {
-		var resHub *dwsv1alpha2.DirectiveBreakdown
+		var resHub *dwsv1alpha3.DirectiveBreakdown
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.DirectiveBreakdown{
+			resHub = &dwsv1alpha3.DirectiveBreakdown{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.DirectiveBreakdownSpec{},
+				Spec: dwsv1alpha3.DirectiveBreakdownSpec{},
 			}
 
 			Expect(k8sClient.Create(context.TODO(), resHub)).To(Succeed())
@@ -171,14 +189,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.DirectiveBreakdown{}
+				expected := &dwsv1alpha3.DirectiveBreakdown{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads DirectiveBreakdown resource via hub and via spoke", func() {
+		It("reads DirectiveBreakdown resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.DirectiveBreakdown{}
 			Eventually(func(g Gomega) {
@@ -188,6 +206,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.DirectiveBreakdown{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -198,16 +225,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("PersistentStorageInstance", func() {
-		var resHub *dwsv1alpha2.PersistentStorageInstance
+		var resHub *dwsv1alpha3.PersistentStorageInstance
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.PersistentStorageInstance{
+			resHub = &dwsv1alpha3.PersistentStorageInstance{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.PersistentStorageInstanceSpec{
+				Spec: dwsv1alpha3.PersistentStorageInstanceSpec{
 					Name:        "",
 					FsType:      "raw",
 					DWDirective: "",
@@ -222,14 +249,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.PersistentStorageInstance{}
+				expected := &dwsv1alpha3.PersistentStorageInstance{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads PersistentStorageInstance resource via hub and via spoke", func() {
+		It("reads PersistentStorageInstance resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.PersistentStorageInstance{}
 			Eventually(func(g Gomega) {
@@ -239,6 +266,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.PersistentStorageInstance{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -249,16 +285,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("Servers", func() {
-		var resHub *dwsv1alpha2.Servers
+		var resHub *dwsv1alpha3.Servers
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.Servers{
+			resHub = &dwsv1alpha3.Servers{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.ServersSpec{},
+				Spec: dwsv1alpha3.ServersSpec{},
 			}
 
 			Expect(k8sClient.Create(context.TODO(), resHub)).To(Succeed())
@@ -267,14 +303,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.Servers{}
+				expected := &dwsv1alpha3.Servers{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads Servers resource via hub and via spoke", func() {
+		It("reads Servers resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.Servers{}
 			Eventually(func(g Gomega) {
@@ -284,6 +320,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.Servers{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -294,16 +339,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("Storage", func() {
-		var resHub *dwsv1alpha2.Storage
+		var resHub *dwsv1alpha3.Storage
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.Storage{
+			resHub = &dwsv1alpha3.Storage{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.StorageSpec{},
+				Spec: dwsv1alpha3.StorageSpec{},
 			}
 
 			Expect(k8sClient.Create(context.TODO(), resHub)).To(Succeed())
@@ -312,14 +357,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.Storage{}
+				expected := &dwsv1alpha3.Storage{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads Storage resource via hub and via spoke", func() {
+		It("reads Storage resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.Storage{}
 			Eventually(func(g Gomega) {
@@ -329,6 +374,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.Storage{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -339,16 +393,16 @@ var _ = Describe("Conversion Webhook Test", func() {
 	})
 
 	Context("SystemConfiguration", func() {
-		var resHub *dwsv1alpha2.SystemConfiguration
+		var resHub *dwsv1alpha3.SystemConfiguration
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.SystemConfiguration{
+			resHub = &dwsv1alpha3.SystemConfiguration{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.SystemConfigurationSpec{},
+				Spec: dwsv1alpha3.SystemConfigurationSpec{},
 			}
 
 			Expect(k8sClient.Create(context.TODO(), resHub)).To(Succeed())
@@ -357,14 +411,14 @@ var _ = Describe("Conversion Webhook Test", func() {
 		AfterEach(func() {
 			if resHub != nil {
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.SystemConfiguration{}
+				expected := &dwsv1alpha3.SystemConfiguration{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads SystemConfiguration resource via hub and via spoke", func() {
+		It("reads SystemConfiguration resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.SystemConfiguration{}
 			Eventually(func(g Gomega) {
@@ -374,6 +428,15 @@ var _ = Describe("Conversion Webhook Test", func() {
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.SystemConfiguration{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
+				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
 			// Hub should not have annotation.
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
@@ -383,18 +446,20 @@ var _ = Describe("Conversion Webhook Test", func() {
 		})
 	})
 
+	// The workflow webhook adds its own annotations, so the Workflow tests look for the conversion
+	// data annotation by key
 	Context("Workflow", func() {
-		var resHub *dwsv1alpha2.Workflow
+		var resHub *dwsv1alpha3.Workflow
 
 		BeforeEach(func() {
 			id := uuid.NewString()[0:8]
-			resHub = &dwsv1alpha2.Workflow{
+			resHub = &dwsv1alpha3.Workflow{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      id,
 					Namespace: corev1.NamespaceDefault,
 				},
-				Spec: dwsv1alpha2.WorkflowSpec{
-					DesiredState: dwsv1alpha2.StateProposal,
+				Spec: dwsv1alpha3.WorkflowSpec{
+					DesiredState: dwsv1alpha3.StateProposal,
 					WLMID:        "test",
 					JobID:        intstr.FromString("a job id 42"),
 					UserID:       0,
@@ -410,21 +475,34 @@ var _ = Describe("Conversion Webhook Test", func() {
 
 		AfterEach(func() {
 			if resHub != nil {
+				// The workflow hasn't finished Teardown, so it can only be deleted when forced
+				Eventually(func() error {
+					Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
+					metav1.SetMetaDataAnnotation(&resHub.ObjectMeta, dwsv1alpha3.WorkflowForceDeleteAnnotation, "true")
+					return k8sClient.Update(context.TODO(), resHub)
+				}).Should(Succeed())
 				Expect(k8sClient.Delete(context.TODO(), resHub)).To(Succeed())
-				expected := &dwsv1alpha2.Workflow{}
+				expected := &dwsv1alpha3.Workflow{}
 				Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 					return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), expected)
 				}).ShouldNot(Succeed())
 			}
 		})
 
-		It("reads Workflow resource via hub and via spoke", func() {
+		It("reads Workflow resource via hub and via spokes", func() {
 			// Spoke should have annotation.
 			resSpoke := &dwsv1alpha1.Workflow{}
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke)).To(Succeed())
 				anno := resSpoke.GetAnnotations()
-				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.Workflow{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
@@ -432,11 +510,11 @@ var _ = Describe("Conversion Webhook Test", func() {
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resHub)).To(Succeed())
 				anno := resHub.GetAnnotations()
-				g.Expect(anno).To(HaveLen(0))
+				g.Expect(anno).ShouldNot(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 		})
 
-		It("reads Computes resource via hub and via spoke", func() {
+		It("reads Computes resource via hub and via spokes", func() {
 			// The workflow_controller's Reconcile() created
 			// a Computes resource to match the Workflow resource.
 
@@ -445,16 +523,23 @@ var _ = Describe("Conversion Webhook Test", func() {
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke)).To(Succeed())
 				anno := resSpoke.GetAnnotations()
-				g.Expect(anno).To(HaveLen(1))
+				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
+			}).Should(Succeed())
+
+			// v1alpha2 spoke should also have annotation.
+			resSpoke2 := &dwsv1alpha2.Computes{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), resSpoke2)).To(Succeed())
+				anno := resSpoke2.GetAnnotations()
 				g.Expect(anno).Should(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 
 			// Hub should not have annotation.
-			computeHub := &dwsv1alpha2.Computes{}
+			computeHub := &dwsv1alpha3.Computes{}
 			Eventually(func(g Gomega) {
 				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(resHub), computeHub)).To(Succeed())
 				anno := computeHub.GetAnnotations()
-				g.Expect(anno).To(HaveLen(0))
+				g.Expect(anno).ShouldNot(HaveKey(utilconversion.DataAnnotation))
 			}).Should(Succeed())
 		})
 	})
@@ -1,10 +1,19 @@
 package metrics
 
 import (
+	"context"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 )
 
+// durationBuckets covers one second to about three days, which spans the quick states
+// through long data movement
+var durationBuckets = prometheus.ExponentialBuckets(1, 4, 10)
+
 var (
 	DwsReconcilesTotal = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -12,8 +21,99 @@ var (
 			Help: "Number of total reconciles in DWS controller",
 		},
 	)
+
+	DwsDriverHeartbeatsMissedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dws_driver_heartbeats_missed_total",
+			Help: "Number of times a workflow driver entry was flagged as unresponsive because its heartbeat went stale",
+		},
+		[]string{"driver_id"},
+	)
+
+	DwsWorkflowsReapedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dws_workflows_reaped_total",
+			Help: "Number of finished workflows deleted by the workflow controller after their TTL expired",
+		},
+	)
+
+	DwsWorkflowStateDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dws_workflow_state_duration_seconds",
+			Help:    "Time from a workflow's desiredState change until the state is ready",
+			Buckets: durationBuckets,
+		},
+		[]string{"state"},
+	)
+
+	DwsDriverWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dws_driver_wait_seconds",
+			Help:    "Time from a workflow's desiredState change until a driver completes its work for the state",
+			Buckets: durationBuckets,
+		},
+		[]string{"driver_id", "state"},
+	)
+
+	DwsDriverErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dws_driver_errors_total",
+			Help: "Number of errors reported by workflow drivers",
+		},
+		[]string{"driver_id"},
+	)
 )
 
 func init() {
 	metrics.Registry.MustRegister(DwsReconcilesTotal)
+	metrics.Registry.MustRegister(DwsDriverHeartbeatsMissedTotal)
+	metrics.Registry.MustRegister(DwsWorkflowsReapedTotal)
+	metrics.Registry.MustRegister(DwsWorkflowStateDurationSeconds)
+	metrics.Registry.MustRegister(DwsDriverWaitSeconds)
+	metrics.Registry.MustRegister(DwsDriverErrorsTotal)
+}
+
+var dwsWorkflowsDesc = prometheus.NewDesc(
+	"dws_workflows",
+	"Number of workflows in each state and status",
+	[]string{"state", "status"},
+	nil,
+)
+
+// workflowCollector counts the workflows by state and status when the metrics are
+// scraped. Counting from the cache avoids tracking every state change and deletion.
+type workflowCollector struct {
+	reader client.Reader
+}
+
+func (c *workflowCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dwsWorkflowsDesc
+}
+
+func (c *workflowCollector) Collect(ch chan<- prometheus.Metric) {
+	workflows := &dwsv1alpha3.WorkflowList{}
+	if err := c.reader.List(context.Background(), workflows); err != nil {
+		ch <- prometheus.NewInvalidMetric(dwsWorkflowsDesc, err)
+		return
+	}
+
+	type key struct {
+		state  dwsv1alpha3.WorkflowState
+		status string
+	}
+
+	counts := map[key]int{}
+	for _, workflow := range workflows.Items {
+		counts[key{workflow.Status.State, workflow.Status.Status}]++
+	}
+
+	for k, count := range counts {
+		ch <- prometheus.MustNewConstMetric(dwsWorkflowsDesc, prometheus.GaugeValue, float64(count), string(k.state), k.status)
+	}
+}
+
+// RegisterWorkflowCollector registers the dws_workflows gauge. The reader should be the
+// manager's cached client.
+func RegisterWorkflowCollector(reader client.Reader) error {
+	return metrics.Registry.Register(&workflowCollector{reader: reader})
 }
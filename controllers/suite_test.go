@@ -20,10 +20,13 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -36,6 +39,8 @@ import (
 
 	dwsv1alpha1 "github.com/HewlettPackard/dws/api/v1alpha1"
 	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	"github.com/HewlettPackard/dws/utils/tracing"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -48,6 +53,12 @@ var testEnv *envtest.Environment
 var ctx context.Context
 var cancel context.CancelFunc
 
+// spanExporter collects the spans exported by the webhooks and controllers in process
+var spanExporter = tracetest.NewInMemoryExporter()
+
+// heartbeatInterval is the driver heartbeat interval used by the workflow controller under test
+const heartbeatInterval = 2 * time.Second
+
 func TestAPIs(t *testing.T) {
 	RegisterFailHandler(Fail)
 
@@ -62,6 +73,8 @@ var _ = BeforeSuite(func() {
 
 	ctx, cancel = context.WithCancel(context.TODO())
 
+	tracing.Setup(sdktrace.WithSyncer(spanExporter))
+
 	By("bootstrapping test environment")
 
 	// See https://github.com/kubernetes-sigs/controller-runtime/issues/1882
@@ -77,6 +90,9 @@ var _ = BeforeSuite(func() {
 	err = dwsv1alpha2.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = dwsv1alpha3.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:scheme
 
 	testEnv = &envtest.Environment{
@@ -114,39 +130,48 @@ var _ = BeforeSuite(func() {
 
 	// start webhooks
 
-	err = (&dwsv1alpha2.ClientMount{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.ClientMount{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 	/*
-		err = (&dwsv1alpha2.Computes{}).SetupWebhookWithManager(k8sManager)
+		err = (&dwsv1alpha3.Computes{}).SetupWebhookWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 	*/
 
-	err = (&dwsv1alpha2.DWDirectiveRule{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.DWDirectiveRule{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.DirectiveBreakdown{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.DirectiveBreakdown{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.PersistentStorageInstance{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.PersistentStorageInstance{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.Servers{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.Servers{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.Storage{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.Storage{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.SystemConfiguration{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.SystemConfiguration{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&dwsv1alpha2.Workflow{}).SetupWebhookWithManager(k8sManager)
+	err = (&dwsv1alpha3.Workflow{}).SetupWebhookWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	// start reconcilers
 
 	err = (&WorkflowReconciler{
+		Client:            k8sManager.GetClient(),
+		Log:               ctrl.Log.WithName("controllers").WithName("Workflow"),
+		Scheme:            testEnv.Scheme,
+		Recorder:          k8sManager.GetEventRecorderFor("dws-workflow-controller"),
+		HeartbeatInterval: heartbeatInterval,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&WorkflowQuotaReconciler{
 		Client: k8sManager.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Workflow"),
+		Log:    ctrl.Log.WithName("controllers").WithName("WorkflowQuota"),
 		Scheme: testEnv.Scheme,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
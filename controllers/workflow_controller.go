@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -31,13 +33,16 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	"github.com/HewlettPackard/dws/controllers/metrics"
+	"github.com/HewlettPackard/dws/utils/dwdparse"
 	"github.com/HewlettPackard/dws/utils/updater"
 )
 
@@ -52,17 +57,48 @@ const (
 	ConditionFalse bool = false
 )
 
+// Reasons for the events recorded against a workflow
+const (
+	EventReasonStateTransition    = "StateTransition"
+	EventReasonStateReady         = "StateReady"
+	EventReasonDriverRegistered   = "DriverRegistered"
+	EventReasonRegistrationFailed = "RegistrationFailed"
+	EventReasonDriverError        = "DriverError"
+	EventReasonDriverUnresponsive = "DriverUnresponsive"
+	EventReasonDriverResponsive   = "DriverResponsive"
+	EventReasonStateTimeout       = "StateTimeout"
+	EventReasonHurriedTeardown    = "HurriedTeardown"
+	EventReasonRetry              = "Retry"
+	EventReasonAutoAdvance        = "AutoAdvance"
+	EventReasonDriversCancelled   = "DriversCancelled"
+	EventReasonHandoffCompleted   = "HandoffCompleted"
+	EventReasonHandoffFailed      = "HandoffFailed"
+)
+
 // WorkflowReconciler reconciles a Workflow object
 type WorkflowReconciler struct {
 	client.Client
 	Scheme       *kruntime.Scheme
 	Log          logr.Logger
-	ChildObjects []dwsv1alpha2.ObjectList
+	Recorder     record.EventRecorder
+	ChildObjects []dwsv1alpha3.ObjectList
+
+	// HeartbeatInterval is the maximum age of a driver's heartbeat before the driver
+	// is flagged as unresponsive. Zero disables heartbeat checking.
+	HeartbeatInterval time.Duration
+
+	// DefaultTTLAfterFinished is how long a workflow is kept after it is Ready in Teardown
+	// when the workflow doesn't set spec.ttlSecondsAfterFinished. Zero keeps workflows
+	// until the WLM deletes them.
+	DefaultTTLAfterFinished time.Duration
 }
 
-//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflows,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflows,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflows/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflows/finalizers,verbs=update
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=dwdirectiverules,verbs=get;list;watch
 //+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=computes,verbs=get;create;list;watch;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -80,16 +116,15 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 	metrics.DwsReconcilesTotal.Inc()
 
 	// Fetch the Workflow workflow
-	workflow := &dwsv1alpha2.Workflow{}
+	workflow := &dwsv1alpha3.Workflow{}
 	if err := r.Get(ctx, req.NamespacedName, workflow); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Create a status updater that handles the call to r.Update() if any of the fields
-	// in workflow.Status{} change. This is necessary since Status is not a subresource
-	// of the workflow.
-	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha2.WorkflowStatus](workflow)
-	defer func() { err = statusUpdater.CloseWithUpdate(ctx, r.Client, err) }()
+	// Create a status updater that handles the call to r.Status().Update() if any of the fields
+	// in workflow.Status{} change
+	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha3.WorkflowStatus](workflow)
+	defer func() { err = statusUpdater.CloseWithStatusUpdate(ctx, r.Client.Status(), err) }()
 
 	// Check if the object is being deleted
 	if !workflow.GetDeletionTimestamp().IsZero() {
@@ -102,8 +137,13 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 			return ctrl.Result{}, nil
 		}
 
+		// Stop the predecessor from handing its allocations to a workflow that's going away
+		if err := r.cancelHandoffs(ctx, workflow); err != nil {
+			return ctrl.Result{}, err
+		}
+
 		// Delete all the Computes resources owned by the workflow
-		DeleteStatus, err := dwsv1alpha2.DeleteChildren(ctx, r.Client, r.ChildObjects, workflow)
+		DeleteStatus, err := dwsv1alpha3.DeleteChildren(ctx, r.Client, r.ChildObjects, workflow)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -112,11 +152,20 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 			return ctrl.Result{}, nil
 		}
 
+		// A finished workflow recorded its trace when Teardown was ready. Otherwise the trace is
+		// recorded once the finalizer is removed, so it isn't repeated if the update fails.
+		recordTrace := func() {}
+		if workflow.Status.State != dwsv1alpha3.StateTeardown || !workflow.Status.Ready {
+			recordTrace = traceWorkflow(ctx, workflow, time.Now(), "workflow deleted before Teardown finished")
+		}
+
 		controllerutil.RemoveFinalizer(workflow, finalizerDwsWorkflow)
 		if err := r.Update(ctx, workflow); err != nil {
 			return ctrl.Result{}, err
 		}
 
+		recordTrace()
+
 		return ctrl.Result{}, nil
 	}
 
@@ -130,21 +179,83 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		return ctrl.Result{}, nil
 	}
 
+	// Add the index labels to a workflow created before the webhook added them
+	if !dwsv1alpha3.HasWorkflowIndexLabels(workflow) {
+		dwsv1alpha3.AddWorkflowIndexLabels(workflow)
+		if err := r.Update(ctx, workflow); err != nil {
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Bring the conditions in line with the rest of the status before it's written
+	defer func() { r.setConditions(workflow) }()
+
+	// Pick up any environment variables that were added directly to the Env view
+	workflow.Status.AdoptEnv()
+
+	// Keep track of the time spent suspended so it doesn't count against the state's timeout
+	trackSuspension(workflow, metav1.NowMicro())
+
+	// Record the allocations handed off by the predecessor in the status of both workflows
+	if err := r.syncHandoffs(ctx, workflow); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Need to set Status.State first because the webhook validates this.
 	if workflow.Status.State != workflow.Spec.DesiredState {
 		log.Info("Workflow state transitioning", "state", workflow.Spec.DesiredState)
+
+		// Register the drivers for the directives when the workflow is new. The webhook has
+		// already checked that each directive matches a rule.
+		if workflow.Status.State == "" {
+			if err := workflow.RegisterDrivers(r.Client); err != nil {
+				workflow.Status.Status = dwsv1alpha3.StatusTransientCondition
+				workflow.Status.Message = fmt.Sprintf("Unable to register drivers: %s", err)
+				workflow.Status.Error = dwsv1alpha3.NewResourceError("unable to register drivers", err)
+				r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonRegistrationFailed, "Unable to register drivers: %s", err.Error())
+				return ctrl.Result{}, err
+			}
+
+			for _, driver := range workflow.Status.Drivers {
+				r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonDriverRegistered, "Driver %s registered for state %s (DW Directive %d)", driver.DriverID, driver.WatchState, driver.DWDIndex)
+			}
+		}
+
+		r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonStateTransition, "Transitioning to state %s", workflow.Spec.DesiredState)
+		ts := metav1.NowMicro()
+
+		// Cancel the driver entries for any states the workflow skips on its way to Teardown
+		if workflow.Spec.DesiredState == dwsv1alpha3.StateTeardown {
+			if cancelled := workflow.CancelSkippedDrivers(ts); cancelled > 0 {
+				log.Info("Cancelled drivers for skipped states", "count", cancelled, "hurry", workflow.Spec.Hurry)
+				r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonDriversCancelled, "Cancelled %d driver entries for states skipped by the move to Teardown", cancelled)
+			}
+		}
+
+		// Close out the trace of a state that is left before it was ready
+		if workflow.Status.State != "" && !workflow.Status.Ready {
+			statusUpdater.OnUpdate(traceState(ctx, workflow, ts.Time))
+		}
+
 		workflow.Status.State = workflow.Spec.DesiredState
 		workflow.Status.Ready = ConditionFalse
-		workflow.Status.Status = dwsv1alpha2.StatusDriverWait
+		workflow.Status.Status = dwsv1alpha3.StatusDriverWait
 		workflow.Status.Message = ""
-		ts := metav1.NowMicro()
+		workflow.Status.DirectiveSummaries = nil
 		workflow.Status.DesiredStateChange = &ts
+		resetSuspendedTime(workflow, ts)
+		workflow.Status.StateHistory = append(workflow.Status.StateHistory, dwsv1alpha3.WorkflowStateHistory{
+			State:              workflow.Status.State,
+			DesiredStateChange: &ts,
+		})
 
 		return ctrl.Result{}, nil
 	}
 
 	// We must create Computes during proposal state
-	if workflow.Spec.DesiredState == dwsv1alpha2.StateProposal {
+	if workflow.Spec.DesiredState == dwsv1alpha3.StateProposal {
 		computes, err := r.createComputes(ctx, workflow, workflow.Name, log)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -152,7 +263,7 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 
 		// Ensure the computes reference is set
 		cref := v1.ObjectReference{
-			Kind:      reflect.TypeOf(dwsv1alpha2.Computes{}).Name(),
+			Kind:      reflect.TypeOf(dwsv1alpha3.Computes{}).Name(),
 			Name:      computes.Name,
 			Namespace: computes.Namespace,
 		}
@@ -160,60 +271,585 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 			log.Info("Updating workflow with Computes")
 			workflow.Status.Computes = cref
 
-			err = r.Update(ctx, workflow)
-			if err != nil {
-				log.Error(err, "Failed to add computes reference")
-			}
-			return ctrl.Result{}, err
+			return ctrl.Result{}, nil
 		}
 	}
 
+	if err := r.setComputesAssignedCondition(ctx, workflow); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Give the drivers another attempt at the current state if the WLM requested a retry
+	if workflow.Spec.RetryGeneration != workflow.Status.ObservedRetryGeneration {
+		r.retryState(workflow, log)
+
+		return ctrl.Result{}, nil
+	}
+
 	// If the workflow has already been marked as complete for this state, then
 	// we don't need to check the drivers. The drivers can't transition from complete
 	// to not complete
 	if workflow.Status.Ready == true {
-		return ctrl.Result{}, nil
+		if workflow.Status.State == dwsv1alpha3.StateTeardown {
+			if err := r.pushHandoffs(ctx, workflow); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return r.reapFinishedWorkflow(ctx, workflow, log)
+		}
+
+		return r.autoAdvance(ctx, workflow, log)
 	}
 
+	// Remember the previous status so that warnings are only recorded when it changes
+	previousStatus := workflow.Status.Status
+
 	workflow.Status.Ready = true
-	workflow.Status.Status = dwsv1alpha2.StatusCompleted
+	workflow.Status.Status = dwsv1alpha3.StatusCompleted
 	workflow.Status.Message = ""
+	workflow.Status.Error = nil
+
+	// Flag any drivers for the current state whose heartbeat has gone stale
+	heartbeatsTracked := r.checkDriverHeartbeats(workflow, statusUpdater.OnUpdate, log)
 
 	// Loop through the driver status array and update the workflow
 	// status as necessary
-	for _, driver := range workflow.Status.Drivers {
+	for i := range workflow.Status.Drivers {
+		driver := &workflow.Status.Drivers[i]
 		if driver.WatchState != workflow.Status.State {
 			continue
 		}
 
+		driver.Eligible = workflow.Status.DriverEligible(i)
+
 		if driver.Completed == false {
 			workflow.Status.Ready = false
-			workflow.Status.Status = dwsv1alpha2.StatusDriverWait
+			workflow.Status.Status = dwsv1alpha3.StatusDriverWait
+		} else if driver.CompleteTime == nil {
+			ts := metav1.NowMicro()
+			driver.CompleteTime = &ts
+
+			if workflow.Status.DesiredStateChange != nil {
+				driverID, watchState, wait := driver.DriverID, string(driver.WatchState), ts.Sub(workflow.Status.DesiredStateChange.Time)
+				statusUpdater.OnUpdate(func() { metrics.DwsDriverWaitSeconds.WithLabelValues(driverID, watchState).Observe(wait.Seconds()) })
+			}
+		}
+
+		// Keep the first fatal driver error, or the last recoverable one if none are fatal
+		if driver.Status == dwsv1alpha3.StatusError {
+			driverError := driverError(driver)
+			if workflow.Status.Error == nil || workflow.Status.Error.Recoverable {
+				workflow.Status.Error = driverError
+			}
+
+			// Report each driver's error once
+			if driver.ErrorTime == nil {
+				ts := metav1.NowMicro()
+				driver.ErrorTime = &ts
+
+				r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonDriverError, "DW Directive %d: driver %s reported an error: %s", driver.DWDIndex, driver.DriverID, driverError.Error())
+				driverID := driver.DriverID
+				statusUpdater.OnUpdate(func() { metrics.DwsDriverErrorsTotal.WithLabelValues(driverID).Inc() })
+			}
+		} else {
+			driver.ErrorTime = nil
 		}
+	}
+
+	workflow.Status.DirectiveSummaries, workflow.Status.Message = summarizeDirectives(workflow)
 
-		if driver.Message != "" {
-			workflow.Status.Message = fmt.Sprintf("DW Directive %d: %s", driver.DWDIndex, driver.Message)
+	// A successor can't use its predecessor's allocations in Setup until they're handed off
+	if workflow.Status.State == dwsv1alpha3.StateSetup {
+		checkHandoffs(workflow)
+	}
+
+	if workflow.Status.Error != nil {
+		if workflow.Status.Error.Recoverable {
+			workflow.Status.Status = dwsv1alpha3.StatusTransientCondition
+		} else {
+			workflow.Status.Status = dwsv1alpha3.StatusError
 		}
+	}
+
+	// Check the heartbeats again after the interval in case the drivers stop updating
+	// the workflow entirely
+	result := ctrl.Result{}
+	if workflow.Status.Ready == false && heartbeatsTracked {
+		result.RequeueAfter = r.HeartbeatInterval
+	}
+
+	// Enforce the timeout for the current state if one was requested. The deadline is
+	// measured from the most recent desiredState change, and is paused while the workflow
+	// is suspended.
+	if workflow.Status.Ready == false && !workflow.Spec.Suspend {
+		timeout, found := workflow.Spec.StateTimeouts[workflow.Status.State]
+		if found && workflow.Status.DesiredStateChange != nil {
+			start := workflow.Status.DesiredStateChange
+			if workflow.Status.RetryChange != nil && start.Before(workflow.Status.RetryChange) {
+				start = workflow.Status.RetryChange
+			}
+
+			deadline := start.Add(timeout.Duration)
+			if workflow.Status.SuspendedTime != nil {
+				deadline = deadline.Add(workflow.Status.SuspendedTime.Duration)
+			}
+
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				if previousStatus != dwsv1alpha3.StatusError {
+					r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonStateTimeout, "State %s timed out after %s", workflow.Status.State, timeout.Duration)
+				}
+
+				return r.handleStateTimeout(ctx, workflow, timeout.Duration, log)
+			}
 
-		if driver.Status == dwsv1alpha2.StatusError {
-			workflow.Status.Status = dwsv1alpha2.StatusError
-			break
+			if result.RequeueAfter == 0 || remaining < result.RequeueAfter {
+				result.RequeueAfter = remaining
+			}
 		}
 	}
 
 	if workflow.Status.Ready == true {
 		ts := metav1.NowMicro()
 		workflow.Status.ReadyChange = &ts
-		workflow.Status.ElapsedTimeLastState = ts.Time.Sub(workflow.Status.DesiredStateChange.Time).Round(time.Microsecond).String()
+		elapsed := ts.Time.Sub(workflow.Status.DesiredStateChange.Time)
+		workflow.Status.ElapsedTimeLastState = elapsed.Round(time.Microsecond).String()
+		state := string(workflow.Status.State)
+		statusUpdater.OnUpdate(func() { metrics.DwsWorkflowStateDurationSeconds.WithLabelValues(state).Observe(elapsed.Seconds()) })
+		r.recordStateHistory(workflow)
+		statusUpdater.OnUpdate(traceState(ctx, workflow, ts.Time))
+		if workflow.Status.State == dwsv1alpha3.StateTeardown {
+			r.completeHandoffs(workflow, ts)
+			statusUpdater.OnUpdate(traceWorkflow(ctx, workflow, ts.Time, ""))
+		}
 		log.Info("Workflow transitioning to ready", "state", workflow.Status.State)
+		r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonStateReady, "State %s ready after %s", workflow.Status.State, workflow.Status.ElapsedTimeLastState)
+	}
+
+	return result, nil
+}
+
+// setConditions updates the DirectivesValid, DriversReady, Error, and Suspended conditions from
+// the workflow's spec and status.
+func (r *WorkflowReconciler) setConditions(workflow *dwsv1alpha3.Workflow) {
+	// Status is a subresource of the workflow, so the generation only changes with the spec
+	conditions := &workflow.Status.Conditions
+	generation := workflow.GetGeneration()
+
+	// The webhook rejects any workflow with a directive that doesn't match a DWDirectiveRule
+	dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeDirectivesValid, true, dwsv1alpha3.ConditionReasonSuccess, "")
+
+	switch {
+	case workflow.Status.Ready:
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeDriversReady, true, dwsv1alpha3.ConditionReasonSuccess,
+			fmt.Sprintf("Drivers completed state %s", workflow.Status.State))
+	case workflow.Status.Status == dwsv1alpha3.StatusError:
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeDriversReady, false, dwsv1alpha3.ConditionReasonError, workflow.Status.Message)
+	case workflow.Status.Status == dwsv1alpha3.StatusTransientCondition:
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeDriversReady, false, dwsv1alpha3.ConditionReasonTransientCondition, workflow.Status.Message)
+	default:
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeDriversReady, false, dwsv1alpha3.ConditionReasonDriverWait, workflow.Status.Message)
 	}
 
+	dwsv1alpha3.SetErrorCondition(conditions, generation, workflow.Status.Error)
+
+	if workflow.Spec.Suspend {
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeSuspended, true, dwsv1alpha3.ConditionReasonSuspended,
+			fmt.Sprintf("Workflow suspended in state %s", workflow.Status.State))
+	} else {
+		dwsv1alpha3.SetCondition(conditions, generation, dwsv1alpha3.ConditionTypeSuspended, false, dwsv1alpha3.ConditionReasonSuccess, "")
+	}
+}
+
+// setComputesAssignedCondition sets the ComputesAssigned condition based on whether the WLM
+// has filled in the workflow's Computes resource.
+func (r *WorkflowReconciler) setComputesAssignedCondition(ctx context.Context, workflow *dwsv1alpha3.Workflow) error {
+	conditions := &workflow.Status.Conditions
+
+	if workflow.Status.Computes.Name == "" {
+		dwsv1alpha3.SetCondition(conditions, workflow.GetGeneration(), dwsv1alpha3.ConditionTypeComputesAssigned, false, dwsv1alpha3.ConditionReasonPending, "Computes resource not created")
+		return nil
+	}
+
+	computes := &dwsv1alpha3.Computes{}
+	if err := r.Get(ctx, types.NamespacedName{Name: workflow.Status.Computes.Name, Namespace: workflow.Status.Computes.Namespace}, computes); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if len(computes.Spec.Data) == 0 {
+		dwsv1alpha3.SetCondition(conditions, workflow.GetGeneration(), dwsv1alpha3.ConditionTypeComputesAssigned, false, dwsv1alpha3.ConditionReasonPending, "No compute nodes assigned")
+		return nil
+	}
+
+	dwsv1alpha3.SetCondition(conditions, workflow.GetGeneration(), dwsv1alpha3.ConditionTypeComputesAssigned, true, dwsv1alpha3.ConditionReasonSuccess,
+		fmt.Sprintf("%d compute nodes assigned", len(computes.Spec.Data)))
+
+	return nil
+}
+
+// recordStateHistory fills in the ready time, elapsed time, and driver completion times of the
+// state history entry for the current state.
+func (r *WorkflowReconciler) recordStateHistory(workflow *dwsv1alpha3.Workflow) {
+	// Workflows created before the history was kept won't have an entry for the current state
+	last := len(workflow.Status.StateHistory) - 1
+	if last < 0 || workflow.Status.StateHistory[last].State != workflow.Status.State {
+		workflow.Status.StateHistory = append(workflow.Status.StateHistory, dwsv1alpha3.WorkflowStateHistory{
+			State:              workflow.Status.State,
+			DesiredStateChange: workflow.Status.DesiredStateChange,
+		})
+		last++
+	}
+
+	entry := &workflow.Status.StateHistory[last]
+	entry.ReadyChange = workflow.Status.ReadyChange
+	entry.ElapsedTime = workflow.Status.ElapsedTimeLastState
+	entry.Drivers = []dwsv1alpha3.WorkflowDriverCompletion{}
+
+	for _, driver := range workflow.Status.Drivers {
+		if driver.WatchState != workflow.Status.State {
+			continue
+		}
+
+		entry.Drivers = append(entry.Drivers, dwsv1alpha3.WorkflowDriverCompletion{
+			DriverID:     driver.DriverID,
+			DWDIndex:     driver.DWDIndex,
+			CompleteTime: driver.CompleteTime,
+		})
+	}
+}
+
+// retryState resets the driver entries for the current state that have not completed back to
+// Pending and clears their errors so the drivers make another attempt at the state.
+func (r *WorkflowReconciler) retryState(workflow *dwsv1alpha3.Workflow, log logr.Logger) {
+	drivers := []string{}
+	for i := range workflow.Status.Drivers {
+		driver := &workflow.Status.Drivers[i]
+		if driver.WatchState != workflow.Status.State || driver.Completed {
+			continue
+		}
+
+		driver.Status = dwsv1alpha3.StatusPending
+		driver.Message = ""
+		driver.Error = nil
+		drivers = append(drivers, driver.DriverID)
+	}
+
+	log.Info("Retrying workflow state", "state", workflow.Status.State, "retryGeneration", workflow.Spec.RetryGeneration, "drivers", drivers)
+	r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonRetry, "Retrying state %s for %d drivers", workflow.Status.State, len(drivers))
+
+	ts := metav1.NowMicro()
+	workflow.Status.ObservedRetryGeneration = workflow.Spec.RetryGeneration
+	workflow.Status.RetryChange = &ts
+	resetSuspendedTime(workflow, ts)
+
+	if !workflow.Status.Ready {
+		workflow.Status.Status = dwsv1alpha3.StatusDriverWait
+		workflow.Status.Message = ""
+		workflow.Status.Error = nil
+	}
+}
+
+// trackSuspension records when the workflow is suspended, and adds the time it was suspended to
+// the suspended time when it resumes
+func trackSuspension(workflow *dwsv1alpha3.Workflow, ts metav1.MicroTime) {
+	switch {
+	case workflow.Spec.Suspend && workflow.Status.SuspendChange == nil:
+		workflow.Status.SuspendChange = &ts
+	case !workflow.Spec.Suspend && workflow.Status.SuspendChange != nil:
+		suspended := ts.Sub(workflow.Status.SuspendChange.Time)
+		if workflow.Status.SuspendedTime != nil {
+			suspended += workflow.Status.SuspendedTime.Duration
+		}
+
+		workflow.Status.SuspendedTime = &metav1.Duration{Duration: suspended}
+		workflow.Status.SuspendChange = nil
+	}
+}
+
+// resetSuspendedTime clears the suspended time when the state's timeout restarts. A workflow
+// that is still suspended starts counting its suspended time again from ts.
+func resetSuspendedTime(workflow *dwsv1alpha3.Workflow, ts metav1.MicroTime) {
+	workflow.Status.SuspendedTime = nil
+	if workflow.Status.SuspendChange != nil {
+		workflow.Status.SuspendChange = &ts
+	}
+}
+
+// directiveStatusSeverity orders the statuses of a directive summary so the most severe status
+// of the directive's drivers is reported
+var directiveStatusSeverity = map[string]int{
+	dwsv1alpha3.StatusCompleted:          0,
+	dwsv1alpha3.StatusDriverWait:         1,
+	dwsv1alpha3.StatusTransientCondition: 2,
+	dwsv1alpha3.StatusError:              3,
+}
+
+// summarizeDirectives builds a summary of the driver entries for the current state for each
+// #DW directive. It also returns a message listing every driver that is waiting or has failed.
+func summarizeDirectives(workflow *dwsv1alpha3.Workflow) ([]dwsv1alpha3.WorkflowDirectiveSummary, string) {
+	summaries := []dwsv1alpha3.WorkflowDirectiveSummary{}
+	problems := map[int][]string{}
+
+	for i := range workflow.Status.Drivers {
+		driver := &workflow.Status.Drivers[i]
+		if driver.WatchState != workflow.Status.State {
+			continue
+		}
+
+		index := -1
+		for j := range summaries {
+			if summaries[j].DWDIndex == driver.DWDIndex {
+				index = j
+				break
+			}
+		}
+
+		if index == -1 {
+			index = len(summaries)
+			summaries = append(summaries, dwsv1alpha3.WorkflowDirectiveSummary{
+				DWDIndex: driver.DWDIndex,
+				Command:  directiveCommand(workflow, driver.DWDIndex),
+				Status:   dwsv1alpha3.StatusCompleted,
+			})
+		}
+
+		summary := &summaries[index]
+		summary.DriverIDs = append(summary.DriverIDs, driver.DriverID)
+
+		status := dwsv1alpha3.StatusCompleted
+		problem := ""
+		switch {
+		case driver.Status == dwsv1alpha3.StatusError:
+			driverError := driverError(driver)
+			status = dwsv1alpha3.StatusError
+			if driverError.Recoverable {
+				status = dwsv1alpha3.StatusTransientCondition
+			}
+			problem = fmt.Sprintf("driver %s failed: %s", driver.DriverID, driverError.Error())
+		case driver.Completed:
+			summary.Completed++
+		case driver.Unresponsive:
+			status = dwsv1alpha3.StatusDriverWait
+			problem = fmt.Sprintf("driver %s unresponsive, last heartbeat at %s", driver.DriverID, driver.LastHB.UTC().Format(time.RFC3339))
+		case !driver.Eligible:
+			status = dwsv1alpha3.StatusDriverWait
+			problem = fmt.Sprintf("driver %s waiting on other drivers", driver.DriverID)
+		default:
+			status = dwsv1alpha3.StatusDriverWait
+			problem = fmt.Sprintf("driver %s waiting", driver.DriverID)
+			if driver.Message != "" {
+				problem = fmt.Sprintf("%s: %s", problem, driver.Message)
+			}
+		}
+
+		if directiveStatusSeverity[status] > directiveStatusSeverity[summary.Status] {
+			summary.Status = status
+		}
+
+		if problem != "" {
+			problems[driver.DWDIndex] = append(problems[driver.DWDIndex], problem)
+		}
+	}
+
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].DWDIndex < summaries[j].DWDIndex })
+
+	messages := []string{}
+	for i := range summaries {
+		summary := &summaries[i]
+		summary.Message = strings.Join(problems[summary.DWDIndex], "; ")
+		if summary.Message != "" {
+			messages = append(messages, fmt.Sprintf("DW Directive %d (%s): %s", summary.DWDIndex, summary.Command, summary.Message))
+		}
+	}
+
+	return summaries, strings.Join(messages, "; ")
+}
+
+// directiveCommand returns the command of the #DW directive at the index, or an empty string
+// if the directive can't be parsed
+func directiveCommand(workflow *dwsv1alpha3.Workflow, dwdIndex int) string {
+	if dwdIndex < 0 || dwdIndex >= len(workflow.Spec.DWDirectives) {
+		return ""
+	}
+
+	args, err := dwdparse.BuildArgsMap(workflow.Spec.DWDirectives[dwdIndex])
+	if err != nil {
+		return ""
+	}
+
+	return args["command"]
+}
+
+// driverError returns the error information reported by a driver. A driver that sets an error
+// status without any error information is treated as having a fatal error.
+func driverError(driver *dwsv1alpha3.WorkflowDriverStatus) *dwsv1alpha3.ResourceErrorInfo {
+	if driver.Error != nil {
+		return driver.Error.DeepCopy()
+	}
+
+	return dwsv1alpha3.NewResourceError(fmt.Sprintf("driver %s reported an error", driver.DriverID), nil).WithFatal()
+}
+
+// checkDriverHeartbeats flags the driver entries for the current state whose heartbeat is older
+// than the heartbeat interval. Drivers that have never reported a heartbeat are not tracked.
+// Returns true if any incomplete driver entry for the current state is being tracked. The missed
+// heartbeat metric is recorded through onUpdate once the flag has been written.
+func (r *WorkflowReconciler) checkDriverHeartbeats(workflow *dwsv1alpha3.Workflow, onUpdate func(func()), log logr.Logger) bool {
+	if r.HeartbeatInterval <= 0 {
+		return false
+	}
+
+	tracked := false
+	for i := range workflow.Status.Drivers {
+		driver := &workflow.Status.Drivers[i]
+		if driver.WatchState != workflow.Status.State || driver.LastHB == nil {
+			continue
+		}
+
+		// A driver that finished the state no longer needs a heartbeat
+		if driver.Completed {
+			driver.Unresponsive = false
+			continue
+		}
+
+		tracked = true
+		lastHB := driver.LastHB.Time
+
+		if time.Since(lastHB) <= r.HeartbeatInterval {
+			// The driver came back after being flagged
+			if driver.Unresponsive {
+				log.Info("Driver heartbeat resumed", "driver", driver.DriverID, "dwdIndex", driver.DWDIndex)
+				r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonDriverResponsive, "DW Directive %d: driver %s heartbeat resumed", driver.DWDIndex, driver.DriverID)
+				driver.Unresponsive = false
+			}
+
+			continue
+		}
+
+		if !driver.Unresponsive {
+			log.Info("Driver heartbeat is stale", "driver", driver.DriverID, "dwdIndex", driver.DWDIndex, "lastHB", lastHB)
+			driverID := driver.DriverID
+			onUpdate(func() { metrics.DwsDriverHeartbeatsMissedTotal.WithLabelValues(driverID).Inc() })
+			r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonDriverUnresponsive, "DW Directive %d: driver %s unresponsive, last heartbeat at %s", driver.DWDIndex, driver.DriverID, lastHB.UTC().Format(time.RFC3339))
+			driver.Unresponsive = true
+		}
+	}
+
+	return tracked
+}
+
+// handleStateTimeout marks the workflow with an error naming the drivers that did not finish
+// the current state before its timeout expired. If the timeout policy requests it, the workflow
+// is also moved to Teardown with the hurry flag set.
+func (r *WorkflowReconciler) handleStateTimeout(ctx context.Context, workflow *dwsv1alpha3.Workflow, timeout time.Duration, log logr.Logger) (ctrl.Result, error) {
+	drivers := []string{}
+	for _, driver := range workflow.Status.Drivers {
+		if driver.WatchState != workflow.Status.State || driver.Completed {
+			continue
+		}
+
+		drivers = append(drivers, fmt.Sprintf("%s (DW Directive %d)", driver.DriverID, driver.DWDIndex))
+	}
+
+	workflow.Status.Status = dwsv1alpha3.StatusError
+	workflow.Status.Message = fmt.Sprintf("State %s timed out after %s waiting for drivers: %s", workflow.Status.State, timeout, strings.Join(drivers, ", "))
+	workflow.Status.Error = dwsv1alpha3.NewResourceError(workflow.Status.Message, nil).WithFatal()
+
+	if workflow.Spec.TimeoutPolicy != dwsv1alpha3.TimeoutPolicyHurriedTeardown || workflow.Spec.DesiredState == dwsv1alpha3.StateTeardown {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Workflow state timed out, starting hurried teardown", "state", workflow.Status.State, "timeout", timeout)
+	workflow.Spec.DesiredState = dwsv1alpha3.StateTeardown
+	workflow.Spec.Hurry = true
+
+	if err := r.updateSpec(ctx, workflow); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonHurriedTeardown, "Starting hurried teardown after state %s timed out", workflow.Status.State)
+
+	return ctrl.Result{}, nil
+}
+
+// updateSpec writes the workflow's spec and metadata. The update doesn't write the status
+// subresource and returns the stored status, so the status is put back afterwards to be
+// written by the status updater.
+func (r *WorkflowReconciler) updateSpec(ctx context.Context, workflow *dwsv1alpha3.Workflow) error {
+	status := workflow.Status.DeepCopy()
+	err := r.Update(ctx, workflow)
+	workflow.Status = *status
+
+	return err
+}
+
+// autoAdvance moves the desired state on to the next state if the workflow's auto advance
+// policy allows it
+func (r *WorkflowReconciler) autoAdvance(ctx context.Context, workflow *dwsv1alpha3.Workflow, log logr.Logger) (ctrl.Result, error) {
+	next, ok := workflow.NextAutoAdvanceState()
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Automatically advancing workflow", "state", next, "stopState", workflow.Spec.AutoAdvance.StopState)
+	workflow.Spec.DesiredState = next
+
+	if err := r.updateSpec(ctx, workflow); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonAutoAdvance, "Advancing to state %s which has no registered drivers", next)
+
+	return ctrl.Result{}, nil
+}
+
+// reapFinishedWorkflow deletes a workflow that has been Ready in Teardown for longer than its
+// TTL. The workflow's finalizer takes care of deleting its children.
+func (r *WorkflowReconciler) reapFinishedWorkflow(ctx context.Context, workflow *dwsv1alpha3.Workflow, log logr.Logger) (ctrl.Result, error) {
+	// A suspended workflow is kept until it resumes
+	if workflow.Spec.Suspend {
+		return ctrl.Result{}, nil
+	}
+
+	ttl := r.DefaultTTLAfterFinished
+	if workflow.Spec.TTLSecondsAfterFinished != nil {
+		ttl = time.Duration(*workflow.Spec.TTLSecondsAfterFinished) * time.Second
+	} else if ttl == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if workflow.Status.ReadyChange == nil {
+		return ctrl.Result{}, nil
+	}
+
+	remaining := time.Until(workflow.Status.ReadyChange.Add(ttl))
+	if remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Info("Deleting finished workflow", "ttl", ttl)
+	if err := r.Delete(ctx, workflow); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	metrics.DwsWorkflowsReapedTotal.Inc()
+
 	return ctrl.Result{}, nil
 }
 
-func (r *WorkflowReconciler) createComputes(ctx context.Context, wf *dwsv1alpha2.Workflow, name string, log logr.Logger) (*dwsv1alpha2.Computes, error) {
+func (r *WorkflowReconciler) createComputes(ctx context.Context, wf *dwsv1alpha3.Workflow, name string, log logr.Logger) (*dwsv1alpha3.Computes, error) {
 
-	computes := &dwsv1alpha2.Computes{
+	computes := &dwsv1alpha3.Computes{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: wf.Namespace,
@@ -222,8 +858,8 @@ func (r *WorkflowReconciler) createComputes(ctx context.Context, wf *dwsv1alpha2
 
 	result, err := ctrl.CreateOrUpdate(ctx, r.Client, computes,
 		func() error {
-			dwsv1alpha2.AddWorkflowLabels(computes, wf)
-			dwsv1alpha2.AddOwnerLabels(computes, wf)
+			dwsv1alpha3.AddWorkflowLabels(computes, wf)
+			dwsv1alpha3.AddOwnerLabels(computes, wf)
 
 			// Link the Computes to the workflow
 			return ctrl.SetControllerReference(wf, computes, r.Scheme)
@@ -245,11 +881,11 @@ func (r *WorkflowReconciler) createComputes(ctx context.Context, wf *dwsv1alpha2
 }
 
 type workflowStatusUpdater struct {
-	workflow       *dwsv1alpha2.Workflow
-	existingStatus dwsv1alpha2.WorkflowStatus
+	workflow       *dwsv1alpha3.Workflow
+	existingStatus dwsv1alpha3.WorkflowStatus
 }
 
-func newWorkflowStatusUpdater(w *dwsv1alpha2.Workflow) *workflowStatusUpdater {
+func newWorkflowStatusUpdater(w *dwsv1alpha3.Workflow) *workflowStatusUpdater {
 	return &workflowStatusUpdater{
 		workflow:       w,
 		existingStatus: (*w.DeepCopy()).Status,
@@ -258,7 +894,7 @@ func newWorkflowStatusUpdater(w *dwsv1alpha2.Workflow) *workflowStatusUpdater {
 
 func (w *workflowStatusUpdater) close(ctx context.Context, r *WorkflowReconciler) error {
 	if !reflect.DeepEqual(w.workflow.Status, w.existingStatus) {
-		err := r.Update(ctx, w.workflow)
+		err := r.Status().Update(ctx, w.workflow)
 		if !apierrors.IsConflict(err) {
 			return err
 		}
@@ -269,14 +905,18 @@ func (w *workflowStatusUpdater) close(ctx context.Context, r *WorkflowReconciler
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.ChildObjects = []dwsv1alpha2.ObjectList{
-		&dwsv1alpha2.ComputesList{},
+	r.ChildObjects = []dwsv1alpha3.ObjectList{
+		&dwsv1alpha3.ComputesList{},
+	}
+
+	if err := metrics.RegisterWorkflowCollector(mgr.GetClient()); err != nil {
+		return err
 	}
 
 	maxReconciles := runtime.GOMAXPROCS(0)
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{MaxConcurrentReconciles: maxReconciles}).
-		For(&dwsv1alpha2.Workflow{}).
-		Owns(&dwsv1alpha2.Computes{}).
+		For(&dwsv1alpha3.Workflow{}).
+		Owns(&dwsv1alpha3.Computes{}).
 		Complete(r)
 }
@@ -22,34 +22,91 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	"github.com/HewlettPackard/dws/utils/dwdparse"
+	"github.com/HewlettPackard/dws/utils/tracing"
 )
 
+// createDriverRuleSet creates a DWDirectiveRule that registers a driver for each of the watch
+// states when a workflow contains the directive "#DW <name>". The driver ID is the rule set name.
+func createDriverRuleSet(name string, watchStates ...dwsv1alpha3.WorkflowState) *dwsv1alpha3.DWDirectiveRule {
+	ruleSet := &dwsv1alpha3.DWDirectiveRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: []dwsv1alpha3.DWDirectiveRuleSpec{
+			{
+				Command:     name,
+				WatchStates: watchStates,
+				RuleDefs:    []dwdparse.DWDirectiveRuleDef{},
+			},
+		},
+	}
+	Expect(k8sClient.Create(context.TODO(), ruleSet)).To(Succeed())
+
+	return ruleSet
+}
+
+// gatherMetric returns the metric with the name and labels from the controller-runtime
+// registry, or nil if it hasn't been recorded
+func gatherMetric(g Gomega, name string, labels map[string]string) *dto.Metric {
+	families, err := ctrlmetrics.Registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, label := range metric.GetLabel() {
+				if value, found := labels[label.GetName()]; found && value == label.GetValue() {
+					matched++
+				}
+			}
+
+			if matched == len(labels) {
+				return metric
+			}
+		}
+	}
+
+	return nil
+}
+
 var _ = Describe("Workflow Controller Test", func() {
 
 	var (
-		wf *dwsv1alpha2.Workflow
+		wf *dwsv1alpha3.Workflow
 	)
 
 	BeforeEach(func() {
 		wfid := uuid.NewString()[0:8]
-		wf = &dwsv1alpha2.Workflow{
+		wf = &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      fmt.Sprintf("%s", wfid),
 				Namespace: corev1.NamespaceDefault,
 			},
-			Spec: dwsv1alpha2.WorkflowSpec{
-				DesiredState: dwsv1alpha2.StateProposal,
+			Spec: dwsv1alpha3.WorkflowSpec{
+				DesiredState: dwsv1alpha3.StateProposal,
 				WLMID:        "test",
 				JobID:        intstr.FromString("wlm job 442"),
 				UserID:       0,
@@ -61,9 +118,15 @@ var _ = Describe("Workflow Controller Test", func() {
 
 	AfterEach(func() {
 		if wf != nil {
+			// Most tests leave the workflow before Teardown
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&wf.ObjectMeta, dwsv1alpha3.WorkflowForceDeleteAnnotation, "true")
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
 			Expect(k8sClient.Delete(context.TODO(), wf)).To(Succeed())
 
-			wfExpected := &dwsv1alpha2.Workflow{}
+			wfExpected := &dwsv1alpha3.Workflow{}
 			Eventually(func() error { // Delete can still return the cached object. Wait until the object is no longer present.
 				return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wfExpected)
 			}).ShouldNot(Succeed())
@@ -76,7 +139,7 @@ var _ = Describe("Workflow Controller Test", func() {
 		Eventually(func(g Gomega) string {
 			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
 			return wf.Status.Status
-		}).Should(Equal(dwsv1alpha2.StatusCompleted))
+		}).Should(Equal(dwsv1alpha3.StatusCompleted))
 
 	})
 
@@ -92,7 +155,7 @@ var _ = Describe("Workflow Controller Test", func() {
 		Eventually(func(g Gomega) string {
 			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
 			return wf.Status.Status
-		}).Should(Equal(dwsv1alpha2.StatusCompleted))
+		}).Should(Equal(dwsv1alpha3.StatusCompleted))
 
 		wf.Spec.Hurry = true
 		Expect(k8sClient.Update(context.TODO(), wf)).ToNot(Succeed())
@@ -104,10 +167,920 @@ var _ = Describe("Workflow Controller Test", func() {
 		Eventually(func(g Gomega) string {
 			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
 			return wf.Status.Status
-		}).Should(Equal(dwsv1alpha2.StatusCompleted))
+		}).Should(Equal(dwsv1alpha3.StatusCompleted))
 
-		wf.Spec.DesiredState = dwsv1alpha2.StateTeardown
+		wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
 		wf.Spec.Hurry = true
 		Expect(k8sClient.Update(context.TODO(), wf)).To(Succeed())
 	})
+
+	It("Sets the workflow conditions", func() {
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return meta.IsStatusConditionTrue(wf.Status.Conditions, dwsv1alpha3.ConditionTypeDriversReady)
+		}).Should(BeTrue())
+
+		Expect(meta.IsStatusConditionTrue(wf.Status.Conditions, dwsv1alpha3.ConditionTypeDirectivesValid)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(wf.Status.Conditions, dwsv1alpha3.ConditionTypeComputesAssigned)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(wf.Status.Conditions, dwsv1alpha3.ConditionTypeError)).To(BeTrue())
+
+		// Assign a compute node the way the WLM would
+		computes := &dwsv1alpha3.Computes{}
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), computes)).To(Succeed())
+		computes.Spec.Data = []dwsv1alpha3.ComputesData{{Name: "compute-01"}}
+		Expect(k8sClient.Update(context.TODO(), computes)).To(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return meta.IsStatusConditionTrue(wf.Status.Conditions, dwsv1alpha3.ConditionTypeComputesAssigned)
+		}).Should(BeTrue())
+	})
+
+	It("Sets the DWS environment variables", func() {
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) map[string]string {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.Env
+		}).Should(HaveKeyWithValue("DW_WORKFLOW_NAME", wf.Name))
+
+		Expect(wf.Status.Env).To(HaveKeyWithValue("DW_WORKFLOW_NAMESPACE", wf.Namespace))
+		Expect(wf.Status.EnvVars).To(ContainElement(dwsv1alpha3.WorkflowEnvVar{Name: "DW_WORKFLOW_NAME", Value: wf.Name, DriverID: dwsv1alpha3.EnvSourceDWS, DWDIndex: -1}))
+	})
+
+	It("Moves environment variables set directly in Env into EnvVars", func() {
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.State
+		}).Should(Equal(dwsv1alpha3.StateProposal))
+
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			wf.Status.Env["DW_JOB_STRIPED"] = "/mnt/striped"
+			return k8sClient.Status().Update(context.TODO(), wf)
+		}).Should(Succeed())
+
+		Eventually(func(g Gomega) []dwsv1alpha3.WorkflowEnvVar {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.EnvVars
+		}).Should(ContainElement(dwsv1alpha3.WorkflowEnvVar{Name: "DW_JOB_STRIPED", Value: "/mnt/striped", DWDIndex: -1}))
+
+		Expect(wf.Status.Env).To(HaveKeyWithValue("DW_WORKFLOW_NAME", wf.Name))
+	})
+
+	It("Suspends and resumes the workflow", func() {
+		wf.Spec.Suspend = true
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.Ready && meta.IsStatusConditionTrue(wf.Status.Conditions, dwsv1alpha3.ConditionTypeSuspended)
+		}).Should(BeTrue())
+
+		wf.Spec.DesiredState = dwsv1alpha3.StateSetup
+		Expect(k8sClient.Update(context.TODO(), wf)).ToNot(Succeed())
+
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			wf.Spec.Suspend = false
+			return k8sClient.Update(context.TODO(), wf)
+		}).Should(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return meta.IsStatusConditionFalse(wf.Status.Conditions, dwsv1alpha3.ConditionTypeSuspended)
+		}).Should(BeTrue())
+
+		wf.Spec.DesiredState = dwsv1alpha3.StateSetup
+		Expect(k8sClient.Update(context.TODO(), wf)).To(Succeed())
+	})
+
+	It("Deletes the workflow once its TTL after finishing expires", func() {
+		ttl := int32(1)
+		wf.Spec.TTLSecondsAfterFinished = &ttl
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.Ready
+		}).Should(BeTrue())
+
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
+			return k8sClient.Update(context.TODO(), wf)
+		}).Should(Succeed())
+
+		Eventually(func() error {
+			return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)
+		}, "5s").ShouldNot(Succeed())
+
+		wf = nil
+	})
+
+	It("Records events for state transitions", func() {
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) []string {
+			events := &corev1.EventList{}
+			g.Expect(k8sClient.List(context.TODO(), events, client.InNamespace(wf.Namespace))).To(Succeed())
+
+			reasons := []string{}
+			for _, event := range events.Items {
+				if event.InvolvedObject.Name == wf.Name {
+					reasons = append(reasons, event.Reason)
+				}
+			}
+
+			return reasons
+		}).Should(ContainElements(EventReasonStateTransition, EventReasonStateReady))
+	})
+
+	It("Records a trace for the workflow", func() {
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+		Expect(wf.GetAnnotations()).To(HaveKey(dwsv1alpha3.WorkflowTraceParentAnnotation))
+
+		root := trace.SpanContextFromContext(tracing.ContextWithTraceParent(context.TODO(), wf.TraceParent()))
+		Expect(root.IsValid()).To(BeTrue())
+
+		Eventually(func(g Gomega) string {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.Status
+		}).Should(Equal(dwsv1alpha3.StatusCompleted))
+
+		wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
+		Expect(k8sClient.Update(context.TODO(), wf)).To(Succeed())
+
+		// The spans of the workflow by name
+		workflowSpans := func() map[string]tracetest.SpanStub {
+			spans := map[string]tracetest.SpanStub{}
+			for _, span := range spanExporter.GetSpans() {
+				if span.SpanContext.TraceID() == root.TraceID() {
+					spans[span.Name] = span
+				}
+			}
+			return spans
+		}
+
+		Eventually(workflowSpans).Should(HaveKey("Workflow"))
+
+		spans := workflowSpans()
+		Expect(spans).To(HaveKey(string(dwsv1alpha3.StateProposal)))
+		Expect(spans).To(HaveKey(string(dwsv1alpha3.StateTeardown)))
+		Expect(spans["Workflow"].SpanContext.SpanID()).To(Equal(root.SpanID()))
+		Expect(spans[string(dwsv1alpha3.StateProposal)].Parent.SpanID()).To(Equal(root.SpanID()))
+	})
+
+	It("Records the state history", func() {
+		ruleSet := createDriverRuleSet("history-"+wf.Name, dwsv1alpha3.StateSetup)
+		DeferCleanup(func() { Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed()) })
+
+		wf.Spec.DWDirectives = []string{"#DW history-" + wf.Name}
+		Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func(g Gomega) string {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.Status
+		}).Should(Equal(dwsv1alpha3.StatusCompleted))
+
+		wf.Spec.DesiredState = dwsv1alpha3.StateSetup
+		Expect(k8sClient.Update(context.TODO(), wf)).To(Succeed())
+
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			wf.Status.Drivers[0].Status = dwsv1alpha3.StatusCompleted
+			wf.Status.Drivers[0].Completed = true
+			return k8sClient.Status().Update(context.TODO(), wf)
+		}).Should(Succeed())
+
+		Eventually(func(g Gomega) bool {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+			return wf.Status.State == dwsv1alpha3.StateSetup && wf.Status.Ready
+		}).Should(BeTrue())
+
+		Expect(wf.Status.StateHistory).To(HaveLen(2))
+		for i, state := range []dwsv1alpha3.WorkflowState{dwsv1alpha3.StateProposal, dwsv1alpha3.StateSetup} {
+			entry := wf.Status.StateHistory[i]
+			Expect(entry.State).To(Equal(state))
+			Expect(entry.DesiredStateChange).ToNot(BeNil())
+			Expect(entry.ReadyChange).ToNot(BeNil())
+			Expect(entry.ElapsedTime).ToNot(BeEmpty())
+		}
+
+		// Only the Setup entry has a driver, and it carries the driver's completion time
+		Expect(wf.Status.StateHistory[0].Drivers).To(BeEmpty())
+		Expect(wf.Status.StateHistory[1].Drivers).To(ConsistOf(dwsv1alpha3.WorkflowDriverCompletion{
+			DriverID:     ruleSet.Name,
+			DWDIndex:     0,
+			CompleteTime: wf.Status.Drivers[0].CompleteTime,
+		}))
+		Expect(wf.Status.StateHistory[1].Drivers[0].CompleteTime).ToNot(BeNil())
+	})
+
+	Context("Auto advance", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			// Register a driver for DataIn so the workflow can't be advanced into it
+			ruleSet = createDriverRuleSet("advance-"+wf.Name, dwsv1alpha3.StateDataIn)
+			wf.Spec.DWDirectives = []string{"#DW advance-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Advances through the states without drivers up to the stop state", func() {
+			wf.Spec.DWDirectives = []string{}
+			wf.Spec.AutoAdvance = &dwsv1alpha3.WorkflowAutoAdvance{StopState: dwsv1alpha3.StatePreRun}
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State == dwsv1alpha3.StatePreRun && wf.Status.Ready
+			}).Should(BeTrue())
+
+			Consistently(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Spec.DesiredState
+			}, "1s").Should(Equal(dwsv1alpha3.StatePreRun))
+		})
+
+		It("Stops before a state with registered drivers", func() {
+			wf.Spec.AutoAdvance = &dwsv1alpha3.WorkflowAutoAdvance{StopState: dwsv1alpha3.StatePreRun}
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State == dwsv1alpha3.StateSetup && wf.Status.Ready
+			}).Should(BeTrue())
+
+			Consistently(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Spec.DesiredState
+			}, "1s").Should(Equal(dwsv1alpha3.StateSetup))
+		})
+	})
+
+	Context("Skipped states", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("skip-"+wf.Name, dwsv1alpha3.StateDataIn, dwsv1alpha3.StatePreRun, dwsv1alpha3.StateTeardown)
+			wf.Spec.DWDirectives = []string{"#DW skip-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Cancels the drivers for states skipped on the way to Teardown", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Ready
+			}).Should(BeTrue())
+
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State
+			}).Should(Equal(dwsv1alpha3.StateTeardown))
+
+			for _, driver := range wf.Status.Drivers {
+				if driver.WatchState == dwsv1alpha3.StateTeardown {
+					Expect(driver.Status).To(Equal(dwsv1alpha3.StatusPending))
+					Expect(driver.CancelTime).To(BeNil())
+				} else {
+					Expect(driver.Status).To(Equal(dwsv1alpha3.StatusCancelled))
+					Expect(driver.CancelTime).ToNot(BeNil())
+				}
+			}
+		})
+	})
+
+	Context("State timeouts", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			// Register a driver for the Proposal state that never completes
+			ruleSet = createDriverRuleSet("timeout-"+wf.Name, dwsv1alpha3.StateProposal)
+
+			wf.Spec.DWDirectives = []string{"#DW timeout-" + wf.Name}
+			wf.Spec.StateTimeouts = map[dwsv1alpha3.WorkflowState]metav1.Duration{
+				dwsv1alpha3.StateProposal: {Duration: time.Second},
+			}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Marks the workflow as an error when the state times out", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusError))
+
+			Expect(wf.Status.Ready).To(BeFalse())
+			Expect(wf.Status.Message).To(ContainSubstring(ruleSet.Name))
+			Expect(wf.Spec.DesiredState).To(Equal(dwsv1alpha3.StateProposal))
+			Expect(wf.Spec.Hurry).To(BeFalse())
+		})
+
+		It("Pauses the timeout while the workflow is suspended", func() {
+			wf.Spec.Suspend = true
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Consistently(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Status
+			}, 3*time.Second).ShouldNot(Equal(dwsv1alpha3.StatusError))
+
+			Expect(wf.Status.SuspendChange).ToNot(BeNil())
+
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Spec.Suspend = false
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			// The deadline is pushed back by the time spent suspended
+			Eventually(func(g Gomega) *metav1.Duration {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.SuspendedTime
+			}).ShouldNot(BeNil())
+
+			Expect(wf.Status.SuspendedTime.Duration).To(BeNumerically(">=", 2*time.Second))
+			Expect(wf.Status.SuspendChange).To(BeNil())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusError))
+		})
+
+		It("Starts a hurried teardown when the state times out", func() {
+			wf.Spec.TimeoutPolicy = dwsv1alpha3.TimeoutPolicyHurriedTeardown
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State
+			}).Should(Equal(dwsv1alpha3.StateTeardown))
+
+			Expect(wf.Spec.DesiredState).To(Equal(dwsv1alpha3.StateTeardown))
+			Expect(wf.Spec.Hurry).To(BeTrue())
+		})
+	})
+
+	Context("Retries", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("retry-"+wf.Name, dwsv1alpha3.StateProposal)
+			wf.Spec.DWDirectives = []string{"#DW retry-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Resets a failed driver when the retry generation is bumped", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State
+			}).Should(Equal(dwsv1alpha3.StateProposal))
+
+			// Fail the driver
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Status.Drivers[0].Status = dwsv1alpha3.StatusError
+				wf.Status.Drivers[0].Error = dwsv1alpha3.NewResourceError("MGS unavailable", nil)
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusTransientCondition))
+
+			// Retry the state
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Spec.RetryGeneration++
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) int64 {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.ObservedRetryGeneration
+			}).Should(Equal(int64(1)))
+
+			Expect(wf.Status.Drivers[0].Status).To(Equal(dwsv1alpha3.StatusPending))
+			Expect(wf.Status.Drivers[0].Error).To(BeNil())
+			Expect(wf.Status.Status).To(Equal(dwsv1alpha3.StatusDriverWait))
+			Expect(wf.Status.Error).To(BeNil())
+		})
+	})
+
+	Context("Driver errors", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("errors-"+wf.Name, dwsv1alpha3.StateProposal)
+			wf.Spec.DWDirectives = []string{"#DW errors-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		DescribeTable("Rolls up the driver error into the workflow",
+			func(driverError *dwsv1alpha3.ResourceErrorInfo, expectedStatus string, expectedReason string) {
+				Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+				Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+					g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+					return wf.Status.State
+				}).Should(Equal(dwsv1alpha3.StateProposal))
+
+				Eventually(func() error {
+					Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+					wf.Status.Drivers[0].Status = dwsv1alpha3.StatusError
+					wf.Status.Drivers[0].Error = driverError
+					return k8sClient.Status().Update(context.TODO(), wf)
+				}).Should(Succeed())
+
+				Eventually(func(g Gomega) string {
+					g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+					return wf.Status.Status
+				}).Should(Equal(expectedStatus))
+
+				Expect(wf.Status.Error).NotTo(BeNil())
+				Expect(wf.Status.Error.Recoverable).To(Equal(expectedStatus == dwsv1alpha3.StatusTransientCondition))
+
+				condition := meta.FindStatusCondition(wf.Status.Conditions, dwsv1alpha3.ConditionTypeError)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(expectedReason))
+			},
+			Entry("When the error is recoverable", dwsv1alpha3.NewResourceError("MGS unavailable", nil), dwsv1alpha3.StatusTransientCondition, dwsv1alpha3.ConditionReasonTransientCondition),
+			Entry("When the error is fatal", dwsv1alpha3.NewResourceError("bad allocation", nil).WithFatal(), dwsv1alpha3.StatusError, dwsv1alpha3.ConditionReasonError),
+			Entry("When the driver gives no error information", nil, dwsv1alpha3.StatusError, dwsv1alpha3.ConditionReasonError),
+		)
+	})
+
+	Context("Directive summaries", func() {
+		var (
+			ruleSets []*dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSets = []*dwsv1alpha3.DWDirectiveRule{
+				createDriverRuleSet("summary-a-"+wf.Name, dwsv1alpha3.StateProposal),
+				createDriverRuleSet("summary-b-"+wf.Name, dwsv1alpha3.StateProposal),
+			}
+			wf.Spec.DWDirectives = []string{"#DW summary-a-" + wf.Name, "#DW summary-b-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			for _, ruleSet := range ruleSets {
+				Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+			}
+		})
+
+		It("Reports every waiting and failed driver", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) []dwsv1alpha3.WorkflowDirectiveSummary {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.DirectiveSummaries
+			}).Should(HaveLen(2))
+
+			// Fail the driver for the second directive and leave the first one waiting
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				for i := range wf.Status.Drivers {
+					if wf.Status.Drivers[i].DWDIndex == 0 {
+						wf.Status.Drivers[i].Message = "allocating storage"
+					} else {
+						wf.Status.Drivers[i].Status = dwsv1alpha3.StatusError
+						wf.Status.Drivers[i].Error = dwsv1alpha3.NewResourceError("MGS unavailable", nil)
+					}
+				}
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusTransientCondition))
+
+			Expect(wf.Status.DirectiveSummaries[0].Command).To(Equal(ruleSets[0].Name))
+			Expect(wf.Status.DirectiveSummaries[0].DriverIDs).To(ConsistOf(ruleSets[0].Name))
+			Expect(wf.Status.DirectiveSummaries[0].Completed).To(Equal(0))
+			Expect(wf.Status.DirectiveSummaries[0].Status).To(Equal(dwsv1alpha3.StatusDriverWait))
+			Expect(wf.Status.DirectiveSummaries[1].Status).To(Equal(dwsv1alpha3.StatusTransientCondition))
+			Expect(wf.Status.DirectiveSummaries[1].Message).To(ContainSubstring("MGS unavailable"))
+
+			Expect(wf.Status.Message).To(ContainSubstring("allocating storage"))
+			Expect(wf.Status.Message).To(ContainSubstring("MGS unavailable"))
+		})
+	})
+
+	Context("Driver dependencies", func() {
+		var (
+			first  *dwsv1alpha3.DWDirectiveRule
+			second *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			first = createDriverRuleSet("first-"+wf.Name, dwsv1alpha3.StateProposal)
+			second = createDriverRuleSet("second-"+wf.Name, dwsv1alpha3.StateProposal)
+
+			second.Spec[0].DependsOn = []string{first.Name}
+			Expect(k8sClient.Update(context.TODO(), second)).To(Succeed())
+
+			wf.Spec.DWDirectives = []string{"#DW second-" + wf.Name, "#DW first-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), first)).To(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), second)).To(Succeed())
+		})
+
+		It("Holds a driver until the drivers it depends on complete", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			driverIndex := func(driverID string) int {
+				for i := range wf.Status.Drivers {
+					if wf.Status.Drivers[i].DriverID == driverID {
+						return i
+					}
+				}
+				return -1
+			}
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				g.Expect(wf.Status.Drivers).To(HaveLen(2))
+				return wf.Status.Drivers[driverIndex(first.Name)].Eligible
+			}).Should(BeTrue())
+			Expect(wf.Status.Drivers[driverIndex(second.Name)].Eligible).To(BeFalse())
+
+			By("Rejecting completion of the dependent driver")
+			wf.Status.Drivers[driverIndex(second.Name)].Status = dwsv1alpha3.StatusCompleted
+			wf.Status.Drivers[driverIndex(second.Name)].Completed = true
+			Expect(k8sClient.Status().Update(context.TODO(), wf)).ToNot(Succeed())
+
+			By("Completing the driver it depends on")
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Status.Drivers[driverIndex(first.Name)].Status = dwsv1alpha3.StatusCompleted
+				wf.Status.Drivers[driverIndex(first.Name)].Completed = true
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Drivers[driverIndex(second.Name)].Eligible
+			}).Should(BeTrue())
+		})
+	})
+
+	Context("Driver heartbeats", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("heartbeat-"+wf.Name, dwsv1alpha3.StateProposal)
+			wf.Spec.DWDirectives = []string{"#DW heartbeat-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Flags a driver whose heartbeat goes stale", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State
+			}).Should(Equal(dwsv1alpha3.StateProposal))
+
+			// Report a heartbeat that is already older than the heartbeat interval
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				lastHB := metav1.NewTime(time.Now().Add(-2 * heartbeatInterval))
+				wf.Status.Drivers[0].LastHB = &lastHB
+				wf.Status.Drivers[0].Status = dwsv1alpha3.StatusRunning
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Drivers[0].Unresponsive
+			}).Should(BeTrue())
+
+			// The status the driver reported is kept
+			Expect(wf.Status.Drivers[0].Status).To(Equal(dwsv1alpha3.StatusRunning))
+			Expect(wf.Status.Message).To(ContainSubstring("unresponsive"))
+			Expect(wf.Status.Message).To(ContainSubstring(ruleSet.Name))
+
+			// A fresh heartbeat clears the flag
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				lastHB := metav1.Now()
+				wf.Status.Drivers[0].LastHB = &lastHB
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Drivers[0].Unresponsive
+			}).Should(BeFalse())
+
+			Expect(wf.Status.Drivers[0].Status).To(Equal(dwsv1alpha3.StatusRunning))
+		})
+	})
+
+	Context("Metrics", func() {
+		var (
+			ruleSet *dwsv1alpha3.DWDirectiveRule
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("metrics-"+wf.Name, dwsv1alpha3.StateProposal)
+			wf.Spec.DWDirectives = []string{"#DW metrics-" + wf.Name}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		It("Records the driver and state metrics", func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+
+			Eventually(func(g Gomega) dwsv1alpha3.WorkflowState {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.State
+			}).Should(Equal(dwsv1alpha3.StateProposal))
+
+			By("Counting the workflow in its state and status")
+			Eventually(func(g Gomega) float64 {
+				metric := gatherMetric(g, "dws_workflows", map[string]string{"state": string(dwsv1alpha3.StateProposal), "status": dwsv1alpha3.StatusDriverWait})
+				g.Expect(metric).ToNot(BeNil())
+				return metric.GetGauge().GetValue()
+			}).Should(BeNumerically(">=", 1))
+
+			By("Counting the driver error")
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Status.Drivers[0].Status = dwsv1alpha3.StatusError
+				wf.Status.Drivers[0].Error = dwsv1alpha3.NewResourceError("MGS unavailable", nil)
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) float64 {
+				metric := gatherMetric(g, "dws_driver_errors_total", map[string]string{"driver_id": ruleSet.Name})
+				g.Expect(metric).ToNot(BeNil())
+				return metric.GetCounter().GetValue()
+			}).Should(BeNumerically(">=", 1))
+
+			By("Observing the driver wait and state duration")
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Status.Drivers[0].Status = dwsv1alpha3.StatusCompleted
+				wf.Status.Drivers[0].Error = nil
+				wf.Status.Drivers[0].Completed = true
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) uint64 {
+				metric := gatherMetric(g, "dws_driver_wait_seconds", map[string]string{"driver_id": ruleSet.Name, "state": string(dwsv1alpha3.StateProposal)})
+				g.Expect(metric).ToNot(BeNil())
+				return metric.GetHistogram().GetSampleCount()
+			}).Should(BeNumerically(">=", 1))
+
+			Eventually(func(g Gomega) uint64 {
+				metric := gatherMetric(g, "dws_workflow_state_duration_seconds", map[string]string{"state": string(dwsv1alpha3.StateProposal)})
+				g.Expect(metric).ToNot(BeNil())
+				return metric.GetHistogram().GetSampleCount()
+			}).Should(BeNumerically(">=", 1))
+		})
+	})
+
+	Context("Chained workflows", func() {
+		var (
+			ruleSet   *dwsv1alpha3.DWDirectiveRule
+			successor *dwsv1alpha3.Workflow
+		)
+
+		BeforeEach(func() {
+			ruleSet = createDriverRuleSet("jobdw")
+			wf.Spec.DWDirectives = []string{"#DW jobdw"}
+
+			successor = &dwsv1alpha3.Workflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wf.Name + "-successor",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: dwsv1alpha3.WorkflowSpec{
+					DesiredState: dwsv1alpha3.StateProposal,
+					WLMID:        "test",
+					JobID:        intstr.FromString("wlm job 443"),
+					DWDirectives: []string{},
+					Predecessor:  &dwsv1alpha3.WorkflowPredecessor{Name: wf.Name, DWDIndexes: []int{0}},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&successor.ObjectMeta, dwsv1alpha3.WorkflowForceDeleteAnnotation, "true")
+				return k8sClient.Update(context.TODO(), successor)
+			}).Should(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), successor)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), &dwsv1alpha3.Workflow{})
+			}).ShouldNot(Succeed())
+
+			Expect(k8sClient.Delete(context.TODO(), ruleSet)).To(Succeed())
+		})
+
+		// createChain creates the predecessor and the successor and waits for the handoff to be
+		// recorded in both
+		createChain := func() {
+			Expect(k8sClient.Create(context.TODO(), wf)).To(Succeed())
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.Ready
+			}).Should(BeTrue())
+
+			Expect(k8sClient.Create(context.TODO(), successor)).To(Succeed())
+
+			Eventually(func(g Gomega) *dwsv1alpha3.WorkflowHandoff {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				return wf.Status.FindHandoff(wf.Name, 0, successor.Name)
+			}).ShouldNot(BeNil())
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				return successor.Status.Ready
+			}).Should(BeTrue())
+
+			handoff := successor.Status.FindHandoff(wf.Name, 0, successor.Name)
+			Expect(handoff).ToNot(BeNil())
+			Expect(handoff.Status).To(Equal(dwsv1alpha3.StatusPending))
+			Expect(handoff.Directive).To(Equal("#DW jobdw"))
+		}
+
+		// advanceSuccessorToSetup moves the successor to Setup once it's ready in Proposal
+		advanceSuccessorToSetup := func() {
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				successor.Spec.DesiredState = dwsv1alpha3.StateSetup
+				return k8sClient.Update(context.TODO(), successor)
+			}).Should(Succeed())
+		}
+
+		It("Holds the successor in Setup until the predecessor hands off the allocation", func() {
+			createChain()
+			advanceSuccessorToSetup()
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				return successor.Status.Message
+			}).Should(ContainSubstring("waiting for workflow " + wf.Name))
+			Expect(successor.Status.Ready).To(BeFalse())
+
+			By("Handing off the predecessor's allocation as its driver would")
+			allocation := &dwsv1alpha3.Servers{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wf.Name + "-allocation",
+					Namespace: wf.Namespace,
+					Labels:    map[string]string{"test-allocation": wf.Name},
+				},
+			}
+			dwsv1alpha3.AddOwnerLabels(allocation, wf)
+			dwsv1alpha3.AddWorkflowLabels(allocation, wf)
+			Expect(k8sClient.Create(context.TODO(), allocation)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(context.TODO(), allocation)).To(Succeed()) })
+
+			matchingLabels := client.MatchingLabels{"test-allocation": wf.Name}
+			Expect(dwsv1alpha3.HandOffChildren(context.TODO(), k8sClient, []dwsv1alpha3.ObjectList{&dwsv1alpha3.ServersList{}}, wf, successor, matchingLabels)).To(Succeed())
+			Expect(matchingLabels).To(Equal(client.MatchingLabels{"test-allocation": wf.Name}))
+
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(allocation), allocation)).To(Succeed())
+			Expect(allocation.GetLabels()).To(HaveKeyWithValue(dwsv1alpha3.OwnerNameLabel, successor.Name))
+			Expect(allocation.GetLabels()).To(HaveKeyWithValue(dwsv1alpha3.WorkflowNameLabel, successor.Name))
+
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Status.FindHandoff(wf.Name, 0, successor.Name).HandedOff = true
+				return k8sClient.Status().Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			By("Finishing the predecessor's Teardown")
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				handoff := wf.Status.FindHandoff(wf.Name, 0, successor.Name)
+				g.Expect(handoff).ToNot(BeNil())
+				return handoff.Status
+			}).Should(Equal(dwsv1alpha3.StatusCompleted))
+
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				return successor.Status.State == dwsv1alpha3.StateSetup && successor.Status.Ready
+			}).Should(BeTrue())
+
+			handoff := successor.Status.FindHandoff(wf.Name, 0, successor.Name)
+			Expect(handoff.Status).To(Equal(dwsv1alpha3.StatusCompleted))
+			Expect(handoff.CompleteTime).ToNot(BeNil())
+		})
+
+		It("Fails the handoff when the predecessor's driver doesn't hand off the allocation", func() {
+			createChain()
+
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				wf.Spec.DesiredState = dwsv1alpha3.StateTeardown
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				handoff := wf.Status.FindHandoff(wf.Name, 0, successor.Name)
+				g.Expect(handoff).ToNot(BeNil())
+				return handoff.Status
+			}).Should(Equal(dwsv1alpha3.StatusError))
+
+			advanceSuccessorToSetup()
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				return successor.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusError))
+		})
+
+		It("Fails the successor when the predecessor is deleted before the handoff", func() {
+			createChain()
+
+			Eventually(func() error {
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&wf.ObjectMeta, dwsv1alpha3.WorkflowForceDeleteAnnotation, "true")
+				return k8sClient.Update(context.TODO(), wf)
+			}).Should(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), wf)).To(Succeed())
+			Eventually(func() error {
+				return k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), &dwsv1alpha3.Workflow{})
+			}).ShouldNot(Succeed())
+			wf = nil
+
+			advanceSuccessorToSetup()
+
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(successor), successor)).To(Succeed())
+				return successor.Status.Status
+			}).Should(Equal(dwsv1alpha3.StatusError))
+
+			handoff := successor.Status.FindHandoff(successor.Spec.Predecessor.Name, 0, successor.Name)
+			Expect(handoff.Status).To(Equal(dwsv1alpha3.StatusError))
+		})
+	})
 })
@@ -0,0 +1,253 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+)
+
+// A chained workflow receives some of the jobdw allocations of its predecessor. The handoff
+// entries are kept in the status of both workflows:
+//   - The successor adds the entries to both workflows when it's reconciled, which tells the
+//     predecessor's drivers to hand the allocations over in Teardown rather than destroy them.
+//   - The predecessor's drivers set handedOff in each entry once they've handed the allocation
+//     over. The predecessor completes the entries when it's Ready in Teardown and copies them
+//     back to the successor before it can be reaped.
+//   - The successor isn't Ready in Setup until each of its handoffs has completed.
+
+// failHandoff marks a pending handoff as failed
+func (r *WorkflowReconciler) failHandoff(workflow *dwsv1alpha3.Workflow, handoff *dwsv1alpha3.WorkflowHandoff, message string) {
+	handoff.Status = dwsv1alpha3.StatusError
+	handoff.Message = message
+
+	r.Recorder.Eventf(workflow, v1.EventTypeWarning, EventReasonHandoffFailed, "Handoff of DW Directive %d from workflow %s failed: %s", handoff.DWDIndex, handoff.Predecessor, message)
+}
+
+// syncHandoffs records the successor's handoffs in the status of both workflows, and picks up
+// the handoffs the predecessor has finished
+func (r *WorkflowReconciler) syncHandoffs(ctx context.Context, workflow *dwsv1alpha3.Workflow) error {
+	if workflow.Spec.Predecessor == nil {
+		return nil
+	}
+
+	name := workflow.Spec.Predecessor.Name
+
+	pending := false
+	for _, dwdIndex := range workflow.Spec.Predecessor.DWDIndexes {
+		handoff := workflow.Status.FindHandoff(name, dwdIndex, workflow.Name)
+		if handoff == nil || handoff.Status == dwsv1alpha3.StatusPending {
+			pending = true
+		}
+	}
+
+	if !pending {
+		return nil
+	}
+
+	predecessor := &dwsv1alpha3.Workflow{}
+	if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: workflow.Namespace}, predecessor); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		predecessor = nil
+	}
+
+	predecessorChanged := false
+	for _, dwdIndex := range workflow.Spec.Predecessor.DWDIndexes {
+		handoff := workflow.Status.FindHandoff(name, dwdIndex, workflow.Name)
+		if handoff == nil {
+			workflow.Status.Handoffs = append(workflow.Status.Handoffs, dwsv1alpha3.WorkflowHandoff{
+				Predecessor: name,
+				Successor:   workflow.Name,
+				DWDIndex:    dwdIndex,
+				Status:      dwsv1alpha3.StatusPending,
+			})
+			handoff = &workflow.Status.Handoffs[len(workflow.Status.Handoffs)-1]
+		}
+
+		if handoff.Status != dwsv1alpha3.StatusPending {
+			continue
+		}
+
+		if predecessor == nil {
+			r.failHandoff(workflow, handoff, "the predecessor workflow was deleted before it handed off the allocation")
+			continue
+		}
+
+		if handoff.Directive == "" && dwdIndex < len(predecessor.Spec.DWDirectives) {
+			handoff.Directive = predecessor.Spec.DWDirectives[dwdIndex]
+		}
+
+		predecessorHandoff := predecessor.Status.FindHandoff(name, dwdIndex, workflow.Name)
+		if predecessorHandoff == nil {
+			// The predecessor's drivers only look for handoffs before they start Teardown
+			if predecessor.Status.State == dwsv1alpha3.StateTeardown || !predecessor.GetDeletionTimestamp().IsZero() {
+				r.failHandoff(workflow, handoff, fmt.Sprintf("the predecessor workflow started %s before the handoff was recorded", dwsv1alpha3.StateTeardown))
+				continue
+			}
+
+			predecessor.Status.Handoffs = append(predecessor.Status.Handoffs, *handoff)
+			predecessorChanged = true
+			continue
+		}
+
+		predecessorHandoff.DeepCopyInto(handoff)
+	}
+
+	if predecessorChanged {
+		return r.Status().Update(ctx, predecessor)
+	}
+
+	return nil
+}
+
+// completeHandoffs marks the pending handoffs of a predecessor as completed. It's called when the
+// predecessor is Ready in Teardown, by which point its drivers have handed off the allocations.
+// A handoff that its driver didn't mark as handed off fails instead.
+func (r *WorkflowReconciler) completeHandoffs(workflow *dwsv1alpha3.Workflow, ts metav1.MicroTime) {
+	for i := range workflow.Status.Handoffs {
+		handoff := &workflow.Status.Handoffs[i]
+		if handoff.Predecessor != workflow.Name || handoff.Status != dwsv1alpha3.StatusPending {
+			continue
+		}
+
+		if !handoff.HandedOff {
+			r.failHandoff(workflow, handoff, "the predecessor workflow finished Teardown without its driver handing off the allocation")
+			continue
+		}
+
+		handoff.Status = dwsv1alpha3.StatusCompleted
+		handoff.CompleteTime = ts.DeepCopy()
+
+		r.Recorder.Eventf(workflow, v1.EventTypeNormal, EventReasonHandoffCompleted, "Handed off DW Directive %d to workflow %s", handoff.DWDIndex, handoff.Successor)
+	}
+}
+
+// pushHandoffs copies the finished handoffs of a predecessor to its successors. The predecessor
+// may be reaped as soon as this succeeds, so the successors can't rely on reading it later.
+func (r *WorkflowReconciler) pushHandoffs(ctx context.Context, workflow *dwsv1alpha3.Workflow) error {
+	for _, handoff := range workflow.Status.Handoffs {
+		if handoff.Predecessor != workflow.Name || handoff.Status == dwsv1alpha3.StatusPending {
+			continue
+		}
+
+		successor := &dwsv1alpha3.Workflow{}
+		if err := r.Get(ctx, client.ObjectKey{Name: handoff.Successor, Namespace: workflow.Namespace}, successor); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return err
+		}
+
+		successorHandoff := successor.Status.FindHandoff(handoff.Predecessor, handoff.DWDIndex, handoff.Successor)
+		switch {
+		case successorHandoff == nil:
+			successor.Status.Handoffs = append(successor.Status.Handoffs, handoff)
+		case successorHandoff.Status == dwsv1alpha3.StatusPending:
+			handoff.DeepCopyInto(successorHandoff)
+		default:
+			continue
+		}
+
+		if err := r.Status().Update(ctx, successor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelHandoffs fails the pending handoffs to a successor that is being deleted so that the
+// predecessor's drivers destroy the allocations rather than hand them off
+func (r *WorkflowReconciler) cancelHandoffs(ctx context.Context, workflow *dwsv1alpha3.Workflow) error {
+	if workflow.Spec.Predecessor == nil {
+		return nil
+	}
+
+	predecessor := &dwsv1alpha3.Workflow{}
+	if err := r.Get(ctx, client.ObjectKey{Name: workflow.Spec.Predecessor.Name, Namespace: workflow.Namespace}, predecessor); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	changed := false
+	for i := range predecessor.Status.Handoffs {
+		handoff := &predecessor.Status.Handoffs[i]
+		if handoff.Successor != workflow.Name || handoff.Status != dwsv1alpha3.StatusPending {
+			continue
+		}
+
+		handoff.Status = dwsv1alpha3.StatusError
+		handoff.Message = "the successor workflow was deleted before the handoff"
+		changed = true
+	}
+
+	if changed {
+		return r.Status().Update(ctx, predecessor)
+	}
+
+	return nil
+}
+
+// checkHandoffs holds a successor in Setup until each of its handoffs has completed. A failed
+// handoff is a fatal error for the successor.
+func checkHandoffs(workflow *dwsv1alpha3.Workflow) {
+	messages := []string{}
+	for _, handoff := range workflow.Status.Handoffs {
+		if handoff.Successor != workflow.Name {
+			continue
+		}
+
+		switch handoff.Status {
+		case dwsv1alpha3.StatusPending:
+			workflow.Status.Ready = false
+			if workflow.Status.Status == dwsv1alpha3.StatusCompleted {
+				workflow.Status.Status = dwsv1alpha3.StatusDriverWait
+			}
+
+			messages = append(messages, fmt.Sprintf("waiting for workflow %s to hand off DW Directive %d", handoff.Predecessor, handoff.DWDIndex))
+		case dwsv1alpha3.StatusError:
+			workflow.Status.Ready = false
+			if workflow.Status.Error == nil || workflow.Status.Error.Recoverable {
+				workflow.Status.Error = dwsv1alpha3.NewResourceError(fmt.Sprintf("handoff of DW Directive %d from workflow %s failed: %s", handoff.DWDIndex, handoff.Predecessor, handoff.Message), nil).WithFatal()
+			}
+
+			messages = append(messages, fmt.Sprintf("handoff of DW Directive %d from workflow %s failed", handoff.DWDIndex, handoff.Predecessor))
+		}
+	}
+
+	if len(messages) > 0 {
+		if workflow.Status.Message != "" {
+			messages = append([]string{workflow.Status.Message}, messages...)
+		}
+
+		workflow.Status.Message = strings.Join(messages, "; ")
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	"github.com/HewlettPackard/dws/utils/tracing"
+)
+
+// Names of the events on a state's span
+const (
+	traceEventDriverRegistered = "driver registered"
+	traceEventDriverCompleted  = "driver completed"
+	traceEventDriverCancelled  = "driver cancelled"
+)
+
+// driverTraceAttributes returns the attributes that identify the driver entry in a span event
+func driverTraceAttributes(driver *dwsv1alpha3.WorkflowDriverStatus) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("dws.driver.id", driver.DriverID),
+		attribute.Int("dws.driver.dwd_index", driver.DWDIndex),
+		attribute.String("dws.driver.watch_state", string(driver.WatchState)),
+	}
+}
+
+// traceState records the span for the workflow's current state, from its desiredState change
+// until end. The span has an event for each driver that completed or was cancelled in the
+// state, and for each driver registration in Proposal. A state that ends without being ready
+// records the workflow's error, if it has one. The span is exported by the returned function, so
+// the caller can wait until the status that ends the state has been written.
+func traceState(ctx context.Context, workflow *dwsv1alpha3.Workflow, end time.Time) func() {
+	if workflow.Status.DesiredStateChange == nil {
+		return func() {}
+	}
+
+	span := tracing.Span{
+		Name:  string(workflow.Status.State),
+		Start: workflow.Status.DesiredStateChange.Time,
+		End:   end,
+		Attributes: append(workflow.TraceAttributes(),
+			attribute.String("dws.workflow.state", string(workflow.Status.State)),
+			attribute.String("dws.workflow.status", workflow.Status.Status),
+			attribute.Bool("dws.workflow.ready", workflow.Status.Ready),
+		),
+	}
+
+	for i := range workflow.Status.Drivers {
+		driver := &workflow.Status.Drivers[i]
+
+		// Drivers are registered as the workflow moves to Proposal
+		if workflow.Status.State == dwsv1alpha3.StateProposal {
+			span.Events = append(span.Events, tracing.Event{Name: traceEventDriverRegistered, Time: span.Start, Attributes: driverTraceAttributes(driver)})
+		}
+
+		if driver.WatchState != workflow.Status.State {
+			continue
+		}
+
+		switch {
+		case driver.CompleteTime != nil:
+			span.Events = append(span.Events, tracing.Event{Name: traceEventDriverCompleted, Time: driver.CompleteTime.Time, Attributes: driverTraceAttributes(driver)})
+		case driver.CancelTime != nil:
+			span.Events = append(span.Events, tracing.Event{Name: traceEventDriverCancelled, Time: driver.CancelTime.Time, Attributes: driverTraceAttributes(driver)})
+		}
+	}
+
+	if !workflow.Status.Ready && workflow.Status.Error != nil {
+		span.Error = workflow.Status.Error.Error()
+	}
+
+	traceParent := workflow.TraceParent()
+	return func() { tracing.RecordSpan(ctx, traceParent, span) }
+}
+
+// traceWorkflow records the root span of the workflow's trace, from its creation until end.
+// A workflow that didn't finish Teardown records the reason as its error. The span is exported by
+// the returned function.
+func traceWorkflow(ctx context.Context, workflow *dwsv1alpha3.Workflow, end time.Time, reason string) func() {
+	traceParent := workflow.TraceParent()
+	span := tracing.Span{
+		Name:  "Workflow",
+		Start: workflow.CreationTimestamp.Time,
+		End:   end,
+		Attributes: append(workflow.TraceAttributes(),
+			attribute.String("dws.workflow.state", string(workflow.Status.State)),
+			attribute.Bool("dws.workflow.hurry", workflow.Spec.Hurry),
+		),
+		Error: reason,
+	}
+
+	return func() { tracing.RecordRootSpan(ctx, traceParent, span) }
+}
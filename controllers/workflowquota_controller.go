@@ -0,0 +1,103 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+	"github.com/HewlettPackard/dws/utils/updater"
+)
+
+// WorkflowQuotaReconciler reconciles a WorkflowQuota object
+type WorkflowQuotaReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflowquotas,verbs=get;list;watch
+//+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=workflowquotas/status,verbs=get;update;patch
+
+// Reconcile updates the usage shown in the status of a WorkflowQuota. The quota itself is
+// enforced by the Workflow webhook.
+func (r *WorkflowQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	quota := &dwsv1alpha3.WorkflowQuota{}
+	if err := r.Get(ctx, req.NamespacedName, quota); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha3.WorkflowQuotaStatus](quota)
+	defer func() { err = statusUpdater.CloseWithStatusUpdate(ctx, r.Client.Status(), err) }()
+
+	workflows := &dwsv1alpha3.WorkflowList{}
+	if err := r.List(ctx, workflows); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	previous := quota.Status.DeepCopy()
+	quota.Status.SetUsage(dwsv1alpha3.NewWorkflowUsage(workflows.Items))
+
+	// Only touch the timestamp when the usage changes so the status update doesn't
+	// cause another reconcile
+	previous.LastUpdate = quota.Status.LastUpdate
+	if !reflect.DeepEqual(*previous, quota.Status) || quota.Status.LastUpdate == nil {
+		ts := metav1.NowMicro()
+		quota.Status.LastUpdate = &ts
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// workflowToQuotas enqueues every WorkflowQuota when a workflow changes, since any workflow
+// can count against any quota
+func (r *WorkflowQuotaReconciler) workflowToQuotas(o client.Object) []reconcile.Request {
+	quotas := &dwsv1alpha3.WorkflowQuotaList{}
+	if err := r.List(context.TODO(), quotas); err != nil {
+		r.Log.Error(err, "Unable to list WorkflowQuotas")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, quota := range quotas.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: quota.Name}})
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkflowQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dwsv1alpha3.WorkflowQuota{}).
+		Watches(&source.Kind{Type: &dwsv1alpha3.Workflow{}}, handler.EnqueueRequestsFromMapFunc(r.workflowToQuotas)).
+		Complete(r)
+}
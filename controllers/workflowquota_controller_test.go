@@ -0,0 +1,128 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
+)
+
+var _ = Describe("WorkflowQuota Controller Test", func() {
+
+	const userID = 4242
+
+	var (
+		quota     *dwsv1alpha3.WorkflowQuota
+		workflows []*dwsv1alpha3.Workflow
+	)
+
+	newWorkflow := func() *dwsv1alpha3.Workflow {
+		wf := &dwsv1alpha3.Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "quota-" + uuid.NewString()[0:8],
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: dwsv1alpha3.WorkflowSpec{
+				DesiredState: dwsv1alpha3.StateProposal,
+				WLMID:        "test",
+				JobID:        intstr.FromString("quota job"),
+				UserID:       userID,
+				GroupID:      userID,
+				DWDirectives: []string{},
+			},
+		}
+		workflows = append(workflows, wf)
+
+		return wf
+	}
+
+	BeforeEach(func() {
+		workflows = []*dwsv1alpha3.Workflow{}
+		quota = &dwsv1alpha3.WorkflowQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "quota-" + uuid.NewString()[0:8],
+			},
+			Spec: dwsv1alpha3.WorkflowQuotaSpec{
+				MaxWorkflowsPerUser: 1,
+			},
+		}
+		Expect(k8sClient.Create(context.TODO(), quota)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), quota)).To(Succeed())
+
+		for _, wf := range workflows {
+			if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(wf), wf); err != nil {
+				continue
+			}
+
+			metav1.SetMetaDataAnnotation(&wf.ObjectMeta, dwsv1alpha3.WorkflowForceDeleteAnnotation, "true")
+			Expect(k8sClient.Update(context.TODO(), wf)).To(Succeed())
+			Expect(k8sClient.Delete(context.TODO(), wf)).To(Succeed())
+		}
+	})
+
+	It("Limits the concurrent workflows for a user", func() {
+		first := newWorkflow()
+		Expect(k8sClient.Create(context.TODO(), first)).To(Succeed())
+
+		Eventually(func(g Gomega) []dwsv1alpha3.WorkflowQuotaUsage {
+			g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			return quota.Status.Users
+		}).Should(ContainElement(dwsv1alpha3.WorkflowQuotaUsage{ID: "4242", Workflows: 1}))
+
+		By("Rejecting a second workflow for the user")
+		err := k8sClient.Create(context.TODO(), newWorkflow())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("user 4242"))
+		Expect(err.Error()).To(ContainSubstring(quota.Name))
+
+		By("Accepting it once the first workflow moves to Teardown")
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(first), first)).To(Succeed())
+			first.Spec.DesiredState = dwsv1alpha3.StateTeardown
+			return k8sClient.Update(context.TODO(), first)
+		}).Should(Succeed())
+
+		Eventually(func() error {
+			return k8sClient.Create(context.TODO(), newWorkflow())
+		}).Should(Succeed())
+	})
+
+	It("Counts a workflow created immediately before", func() {
+		Expect(k8sClient.Create(context.TODO(), newWorkflow())).To(Succeed())
+
+		// The second create doesn't wait for any cache to see the first workflow
+		err := k8sClient.Create(context.TODO(), newWorkflow())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("user 4242"))
+	})
+})
@@ -0,0 +1,366 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+)
+
+// states is the order a workflow progresses through its states
+var states = []dwsv1alpha2.WorkflowState{
+	dwsv1alpha2.StateProposal,
+	dwsv1alpha2.StateSetup,
+	dwsv1alpha2.StateDataIn,
+	dwsv1alpha2.StatePreRun,
+	dwsv1alpha2.StatePostRun,
+	dwsv1alpha2.StateDataOut,
+	dwsv1alpha2.StateTeardown,
+}
+
+// nextState returns the state following s, or an empty state if s is the last state
+func nextState(s dwsv1alpha2.WorkflowState) dwsv1alpha2.WorkflowState {
+	for i := range states[:len(states)-1] {
+		if states[i] == s {
+			return states[i+1]
+		}
+	}
+
+	return ""
+}
+
+// getWorkflow reads the workflow named by the command's only argument
+func getWorkflow(o *options, args []string) (*dwsv1alpha2.Workflow, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected a workflow name")
+	}
+
+	workflow := &dwsv1alpha2.Workflow{}
+	if err := o.client.Get(context.TODO(), client.ObjectKey{Name: args[0], Namespace: o.namespace}, workflow); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// List
+
+var listFilter struct {
+	user  int64
+	job   string
+	state string
+}
+
+var listCommand = command{
+	name:        "list",
+	usage:       "[-user UID] [-job JOBID] [-state STATE]",
+	description: "List the workflows, optionally filtered by user, job, or state",
+	flags: func(fs *flag.FlagSet) {
+		fs.Int64Var(&listFilter.user, "user", -1, "Only list workflows with this user ID")
+		fs.StringVar(&listFilter.job, "job", "", "Only list workflows with this job ID")
+		fs.StringVar(&listFilter.state, "state", "", "Only list workflows in this state")
+	},
+	run: runList,
+}
+
+func runList(o *options, args []string) error {
+	workflows := &dwsv1alpha2.WorkflowList{}
+	if err := o.client.List(context.TODO(), workflows, client.InNamespace(o.namespace)); err != nil {
+		return err
+	}
+
+	filtered := []dwsv1alpha2.Workflow{}
+	for _, workflow := range workflows.Items {
+		if listFilter.user >= 0 && int64(workflow.Spec.UserID) != listFilter.user {
+			continue
+		}
+
+		if listFilter.job != "" && workflow.Spec.JobID.String() != listFilter.job {
+			continue
+		}
+
+		if listFilter.state != "" && string(workflow.Status.State) != listFilter.state {
+			continue
+		}
+
+		filtered = append(filtered, workflow)
+	}
+
+	if o.output == outputJSON {
+		return printJSON(os.Stdout, filtered)
+	}
+
+	rows := [][]string{}
+	for _, workflow := range filtered {
+		rows = append(rows, []string{
+			workflow.Name,
+			string(workflow.Spec.DesiredState),
+			string(workflow.Status.State),
+			strconv.FormatBool(workflow.Status.Ready),
+			workflow.Status.Status,
+			workflow.Spec.JobID.String(),
+			strconv.FormatUint(uint64(workflow.Spec.UserID), 10),
+			strconv.FormatUint(uint64(workflow.Spec.GroupID), 10),
+			age(workflow.CreationTimestamp.Time),
+		})
+	}
+
+	return printTable(os.Stdout, []string{"NAME", "DESIREDSTATE", "STATE", "READY", "STATUS", "JOBID", "USER", "GROUP", "AGE"}, rows)
+}
+
+// Drivers
+
+var driversCommand = command{
+	name:        "drivers",
+	usage:       "WORKFLOW",
+	description: "Show the status of each driver in each state of the workflow",
+	run:         runDrivers,
+}
+
+// driverMatrixRow is a driver's status in each of the states it registered for
+type driverMatrixRow struct {
+	DriverID string                               `json:"driverID"`
+	DWDIndex int                                  `json:"dwdIndex"`
+	States   map[dwsv1alpha2.WorkflowState]string `json:"states"`
+}
+
+// driverMatrix builds a row for each driver and directive from the workflow's driver list
+func driverMatrix(workflow *dwsv1alpha2.Workflow) []driverMatrixRow {
+	type key struct {
+		driverID string
+		dwdIndex int
+	}
+
+	rows := map[key]*driverMatrixRow{}
+	for _, driver := range workflow.Status.Drivers {
+		k := key{driver.DriverID, driver.DWDIndex}
+		if _, found := rows[k]; !found {
+			rows[k] = &driverMatrixRow{
+				DriverID: driver.DriverID,
+				DWDIndex: driver.DWDIndex,
+				States:   map[dwsv1alpha2.WorkflowState]string{},
+			}
+		}
+
+		status := driver.Status
+		switch {
+		case driver.Completed:
+			status = dwsv1alpha2.StatusCompleted
+		case len(driver.Error) > 0:
+			status = dwsv1alpha2.StatusError
+		case len(status) == 0:
+			status = dwsv1alpha2.StatusPending
+		}
+
+		rows[k].States[driver.WatchState] = status
+	}
+
+	matrix := []driverMatrixRow{}
+	for _, row := range rows {
+		matrix = append(matrix, *row)
+	}
+
+	sort.Slice(matrix, func(i, j int) bool {
+		if matrix[i].DWDIndex != matrix[j].DWDIndex {
+			return matrix[i].DWDIndex < matrix[j].DWDIndex
+		}
+
+		return matrix[i].DriverID < matrix[j].DriverID
+	})
+
+	return matrix
+}
+
+func runDrivers(o *options, args []string) error {
+	workflow, err := getWorkflow(o, args)
+	if err != nil {
+		return err
+	}
+
+	matrix := driverMatrix(workflow)
+	if o.output == outputJSON {
+		return printJSON(os.Stdout, matrix)
+	}
+
+	header := []string{"DRIVER", "DWD"}
+	for _, state := range states[1:] {
+		header = append(header, string(state))
+	}
+
+	rows := [][]string{}
+	for _, row := range matrix {
+		cells := []string{row.DriverID, strconv.Itoa(row.DWDIndex)}
+		for _, state := range states[1:] {
+			status, found := row.States[state]
+			if !found {
+				status = "-"
+			}
+			cells = append(cells, status)
+		}
+
+		rows = append(rows, cells)
+	}
+
+	return printTable(os.Stdout, header, rows)
+}
+
+// Advance
+
+var advanceTo string
+
+var advanceCommand = command{
+	name:        "advance",
+	usage:       "[-to STATE] WORKFLOW",
+	description: "Advance the workflow's desired state to the next state once the current state is ready",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&advanceTo, "to", "", "State to advance to. It must be the next state.")
+	},
+	run: runAdvance,
+}
+
+// checkAdvance returns the state the workflow may advance to. It follows the workflow webhook's
+// rules so the update isn't rejected: states may not be skipped and the current desired state
+// must be ready. Use teardown to move to Teardown at any time.
+func checkAdvance(workflow *dwsv1alpha2.Workflow, to dwsv1alpha2.WorkflowState) (dwsv1alpha2.WorkflowState, error) {
+	current := workflow.Spec.DesiredState
+	if current == dwsv1alpha2.StateTeardown {
+		return "", fmt.Errorf("workflow is already in %s", dwsv1alpha2.StateTeardown)
+	}
+
+	if workflow.Status.State != current || !workflow.Status.Ready {
+		return "", fmt.Errorf("desired state %s is not ready: status %s: %s", current, workflow.Status.Status, workflow.Status.Message)
+	}
+
+	next := nextState(current)
+	if len(to) != 0 && to != next {
+		return "", fmt.Errorf("cannot advance from %s to %s: states cannot be skipped, the next state is %s", current, to, next)
+	}
+
+	return next, nil
+}
+
+func runAdvance(o *options, args []string) error {
+	workflow, err := getWorkflow(o, args)
+	if err != nil {
+		return err
+	}
+
+	next, err := checkAdvance(workflow, dwsv1alpha2.WorkflowState(advanceTo))
+	if err != nil {
+		return err
+	}
+
+	// The update carries the resource version that was checked, so it fails if the
+	// workflow changed in the meantime
+	workflow.Spec.DesiredState = next
+	if err := o.client.Update(context.TODO(), workflow); err != nil {
+		return err
+	}
+
+	return printWorkflowChange(o, workflow, fmt.Sprintf("workflow %s advanced to %s", workflow.Name, next))
+}
+
+// Teardown
+
+var teardownHurry bool
+
+var teardownCommand = command{
+	name:        "teardown",
+	usage:       "[-hurry] WORKFLOW",
+	description: "Move the workflow to Teardown, optionally telling the drivers to hurry",
+	flags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&teardownHurry, "hurry", false, "Set the hurry flag so the drivers kill the job and skip data movement")
+	},
+	run: runTeardown,
+}
+
+func runTeardown(o *options, args []string) error {
+	workflow, err := getWorkflow(o, args)
+	if err != nil {
+		return err
+	}
+
+	workflow.Spec.DesiredState = dwsv1alpha2.StateTeardown
+	if teardownHurry {
+		workflow.Spec.Hurry = true
+	}
+
+	if err := o.client.Update(context.TODO(), workflow); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("workflow %s moved to %s", workflow.Name, dwsv1alpha2.StateTeardown)
+	if workflow.Spec.Hurry {
+		message += " in a hurry"
+	}
+
+	return printWorkflowChange(o, workflow, message)
+}
+
+// printWorkflowChange prints the message, or the updated workflow for JSON output
+func printWorkflowChange(o *options, workflow *dwsv1alpha2.Workflow, message string) error {
+	if o.output == outputJSON {
+		return printJSON(os.Stdout, workflow)
+	}
+
+	_, err := fmt.Fprintln(os.Stdout, message)
+	return err
+}
+
+// Env
+
+var envCommand = command{
+	name:        "env",
+	usage:       "WORKFLOW",
+	description: "Print the DW environment variables for the workflow's job",
+	run:         runEnv,
+}
+
+func runEnv(o *options, args []string) error {
+	workflow, err := getWorkflow(o, args)
+	if err != nil {
+		return err
+	}
+
+	if o.output == outputJSON {
+		return printJSON(os.Stdout, workflow.Status.Env)
+	}
+
+	keys := []string{}
+	for key := range workflow.Status.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rows := [][]string{}
+	for _, key := range keys {
+		rows = append(rows, []string{key, workflow.Status.Env[key]})
+	}
+
+	return printTable(os.Stdout, []string{"NAME", "VALUE"}, rows)
+}
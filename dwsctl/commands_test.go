@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+)
+
+func TestCommands(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "dwsctl Test")
+}
+
+var _ = Describe("dwsctl Test", func() {
+
+	It("Builds the driver matrix", func() {
+		workflow := &dwsv1alpha2.Workflow{}
+		workflow.Status.Drivers = []dwsv1alpha2.WorkflowDriverStatus{
+			{DriverID: "b", DWDIndex: 0, WatchState: dwsv1alpha2.StateSetup, Completed: true},
+			{DriverID: "b", DWDIndex: 0, WatchState: dwsv1alpha2.StateTeardown},
+			{DriverID: "a", DWDIndex: 0, WatchState: dwsv1alpha2.StateSetup, Status: dwsv1alpha2.StatusRunning},
+			{DriverID: "a", DWDIndex: 1, WatchState: dwsv1alpha2.StateDataIn, Error: "failed"},
+		}
+
+		Expect(driverMatrix(workflow)).To(Equal([]driverMatrixRow{
+			{DriverID: "a", DWDIndex: 0, States: map[dwsv1alpha2.WorkflowState]string{
+				dwsv1alpha2.StateSetup: dwsv1alpha2.StatusRunning,
+			}},
+			{DriverID: "b", DWDIndex: 0, States: map[dwsv1alpha2.WorkflowState]string{
+				dwsv1alpha2.StateSetup:    dwsv1alpha2.StatusCompleted,
+				dwsv1alpha2.StateTeardown: dwsv1alpha2.StatusPending,
+			}},
+			{DriverID: "a", DWDIndex: 1, States: map[dwsv1alpha2.WorkflowState]string{
+				dwsv1alpha2.StateDataIn: dwsv1alpha2.StatusError,
+			}},
+		}))
+	})
+
+	DescribeTable("Checks the advance",
+		func(desired, state dwsv1alpha2.WorkflowState, ready bool, to dwsv1alpha2.WorkflowState, expected dwsv1alpha2.WorkflowState) {
+			workflow := &dwsv1alpha2.Workflow{}
+			workflow.Spec.DesiredState = desired
+			workflow.Status.State = state
+			workflow.Status.Ready = ready
+
+			next, err := checkAdvance(workflow, to)
+			if len(expected) == 0 {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(Equal(expected))
+			}
+		},
+		Entry("Ready advances to the next state", dwsv1alpha2.StateProposal, dwsv1alpha2.StateProposal, true, dwsv1alpha2.WorkflowState(""), dwsv1alpha2.StateSetup),
+		Entry("Ready advances to the requested next state", dwsv1alpha2.StateSetup, dwsv1alpha2.StateSetup, true, dwsv1alpha2.StateDataIn, dwsv1alpha2.StateDataIn),
+		Entry("Not ready", dwsv1alpha2.StateSetup, dwsv1alpha2.StateSetup, false, dwsv1alpha2.WorkflowState(""), dwsv1alpha2.WorkflowState("")),
+		Entry("State not reached", dwsv1alpha2.StateDataIn, dwsv1alpha2.StateSetup, true, dwsv1alpha2.WorkflowState(""), dwsv1alpha2.WorkflowState("")),
+		Entry("Skipped state", dwsv1alpha2.StateSetup, dwsv1alpha2.StateSetup, true, dwsv1alpha2.StatePreRun, dwsv1alpha2.WorkflowState("")),
+		Entry("Already in teardown", dwsv1alpha2.StateTeardown, dwsv1alpha2.StateTeardown, true, dwsv1alpha2.WorkflowState(""), dwsv1alpha2.WorkflowState("")),
+	)
+})
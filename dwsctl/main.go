@@ -0,0 +1,155 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// dwsctl is a command line tool for operating DWS Workflows. Installed on the PATH as
+// kubectl-dws, it runs as a kubectl plugin (kubectl dws <command>).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+)
+
+const name = "dwsctl"
+
+var scheme = kruntime.NewScheme()
+
+func init() {
+	utilruntime.Must(dwsv1alpha2.AddToScheme(scheme))
+}
+
+// command is a dwsctl subcommand
+type command struct {
+	name        string
+	usage       string
+	description string
+
+	// flags adds the command's flags to the flag set. It may be nil.
+	flags func(fs *flag.FlagSet)
+
+	// run executes the command with the flag set's remaining arguments
+	run func(o *options, args []string) error
+}
+
+var commands = []command{
+	listCommand,
+	driversCommand,
+	advanceCommand,
+	teardownCommand,
+	envCommand,
+}
+
+// options are the flags common to all commands
+type options struct {
+	kubeconfig string
+	namespace  string
+	output     string
+
+	client client.Client
+}
+
+func (o *options) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file")
+	fs.StringVar(&o.namespace, "n", "", "Namespace of the workflows. Defaults to the kubeconfig context's namespace.")
+	fs.StringVar(&o.output, "o", outputTable, "Output format: table or json")
+}
+
+// connect creates the client and resolves the namespace from the kubeconfig
+func (o *options) connect() error {
+	if o.output != outputTable && o.output != outputJSON {
+		return fmt.Errorf("unknown output format '%s'", o.output)
+	}
+
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = o.kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{})
+
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if o.namespace == "" {
+		if o.namespace, _, err = clientConfig.Namespace(); err != nil {
+			return err
+		}
+	}
+
+	o.client, err = client.New(config, client.Options{Scheme: scheme})
+	return err
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags] [arguments]\n\nCommands:\n", name)
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun '%s <command> -h' for the flags of a command.\n", name)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+
+		o := &options{}
+		fs := flag.NewFlagSet(cmd.name, flag.ExitOnError)
+		fs.Usage = func() {
+			fmt.Fprintf(os.Stderr, "Usage: %s %s %s\n\n%s\n\nFlags:\n", name, cmd.name, cmd.usage, cmd.description)
+			fs.PrintDefaults()
+		}
+		o.bindFlags(fs)
+		if cmd.flags != nil {
+			cmd.flags(fs)
+		}
+		_ = fs.Parse(os.Args[2:])
+
+		if err := o.connect(); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+			os.Exit(1)
+		}
+
+		if err := cmd.run(o, fs.Args()); err != nil {
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", name, cmd.name, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	usage()
+	os.Exit(2)
+}
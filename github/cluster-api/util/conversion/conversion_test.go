@@ -26,12 +26,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 )
 
 var (
 	oldWorkflowGVK = schema.GroupVersionKind{
-		Group:   dwsv1alpha2.GroupVersion.Group,
+		Group:   dwsv1alpha3.GroupVersion.Group,
 		Version: "v1old",
 		Kind:    "Workflow",
 	}
@@ -41,14 +41,14 @@ func TestMarshalData(t *testing.T) {
 	g := NewWithT(t)
 
 	t.Run("should write source object to destination", func(t *testing.T) {
-		src := &dwsv1alpha2.Workflow{
+		src := &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test-1",
 				Labels: map[string]string{
 					"label1": "",
 				},
 			},
-			Spec: dwsv1alpha2.WorkflowSpec{
+			Spec: dwsv1alpha3.WorkflowSpec{
 				DesiredState: "Proposal",
 				WLMID:        "special-id",
 				JobID:        intstr.FromString("my wlm job 8128"),
@@ -74,13 +74,13 @@ func TestMarshalData(t *testing.T) {
 	})
 
 	t.Run("should append the annotation", func(t *testing.T) {
-		src := &dwsv1alpha2.Workflow{
+		src := &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test-1",
 			},
 		}
 		dst := &unstructured.Unstructured{}
-		dst.SetGroupVersionKind(dwsv1alpha2.GroupVersion.WithKind("Workflow"))
+		dst.SetGroupVersionKind(dwsv1alpha3.GroupVersion.WithKind("Workflow"))
 		dst.SetName("test-1")
 		dst.SetAnnotations(map[string]string{
 			"annotation": "1",
@@ -95,7 +95,7 @@ func TestUnmarshalData(t *testing.T) {
 	g := NewWithT(t)
 
 	t.Run("should return false without errors if annotation doesn't exist", func(t *testing.T) {
-		src := &dwsv1alpha2.Workflow{
+		src := &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test-1",
 			},
@@ -117,7 +117,7 @@ func TestUnmarshalData(t *testing.T) {
 			DataAnnotation: "{\"metadata\":{\"name\":\"test-1\",\"creationTimestamp\":null,\"labels\":{\"label1\":\"\"}},\"spec\":{},\"status\":{}}",
 		})
 
-		dst := &dwsv1alpha2.Workflow{
+		dst := &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test-1",
 			},
@@ -142,7 +142,7 @@ func TestUnmarshalData(t *testing.T) {
 			DataAnnotation: "{\"metadata\":{\"name\":\"test-1\",\"creationTimestamp\":null,\"labels\":{\"label1\":\"\"}},\"spec\":{},\"status\":{}}",
 		})
 
-		dst := &dwsv1alpha2.Workflow{
+		dst := &dwsv1alpha3.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test-1",
 			},
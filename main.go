@@ -17,14 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"runtime"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -34,7 +37,10 @@ import (
 
 	dwsv1alpha1 "github.com/HewlettPackard/dws/api/v1alpha1"
 	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	"github.com/HewlettPackard/dws/controllers"
+	"github.com/HewlettPackard/dws/utils/audit"
+	"github.com/HewlettPackard/dws/utils/tracing"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -48,6 +54,7 @@ func init() {
 
 	utilruntime.Must(dwsv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(dwsv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(dwsv1alpha3.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -56,12 +63,26 @@ func main() {
 	var enableLeaderElection bool
 	var probeAddr string
 	var mode string
+	var heartbeatInterval time.Duration
+	var ttlAfterFinished time.Duration
+	var otlpEndpoint string
+	var otlpInsecure bool
+	var auditSink string
+	var auditFileOptions audit.FileOptions
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&mode, "mode", "controller", "What mode to run in (controller, webhook)")
+	flag.DurationVar(&heartbeatInterval, "driver-heartbeat-interval", 0, "Maximum age of a workflow driver's heartbeat before it is flagged as unresponsive. Zero disables the check.")
+	flag.DurationVar(&ttlAfterFinished, "workflow-ttl-after-finished", 0, "Default time to keep a workflow after it is ready in Teardown when the workflow doesn't set ttlSecondsAfterFinished. Zero keeps the workflow until it's deleted.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "", "The host:port of the OTLP/HTTP collector that workflow traces are exported to. Empty disables tracing.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Export traces to the OTLP collector without TLS.")
+	flag.StringVar(&auditSink, "audit-log", "", "Where the workflow webhook writes its JSON audit records of admitted changes: 'stdout' or the path of a file that is rotated. Empty disables the audit log.")
+	flag.IntVar(&auditFileOptions.MaxSizeMB, "audit-log-max-size", 100, "Size in megabytes of the audit log file before it's rotated.")
+	flag.IntVar(&auditFileOptions.MaxBackups, "audit-log-max-backups", 10, "Number of rotated audit log files to keep. Zero keeps them all.")
+	flag.IntVar(&auditFileOptions.MaxAgeDays, "audit-log-max-age", 0, "Days to keep rotated audit log files. Zero keeps them regardless of age.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -72,6 +93,19 @@ func main() {
 
 	setupLog.Info("GOMAXPROCS", "value", runtime.GOMAXPROCS(0))
 
+	if otlpEndpoint != "" {
+		exporter, err := tracing.NewOTLPExporter(context.Background(), otlpEndpoint, otlpInsecure)
+		if err != nil {
+			setupLog.Error(err, "unable to create trace exporter")
+			os.Exit(1)
+		}
+
+		provider := tracing.Setup(sdktrace.WithBatcher(exporter))
+		defer func() { _ = provider.Shutdown(context.Background()) }()
+
+		setupLog.Info("exporting traces", "endpoint", otlpEndpoint)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
@@ -88,11 +122,23 @@ func main() {
 	switch mode {
 	case "controller":
 		if err = (&controllers.WorkflowReconciler{
+			Client:                  mgr.GetClient(),
+			Log:                     ctrl.Log.WithName("controllers").WithName("Workflow"),
+			Scheme:                  mgr.GetScheme(),
+			Recorder:                mgr.GetEventRecorderFor("dws-workflow-controller"),
+			HeartbeatInterval:       heartbeatInterval,
+			DefaultTTLAfterFinished: ttlAfterFinished,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Workflow")
+			os.Exit(1)
+		}
+
+		if err = (&controllers.WorkflowQuotaReconciler{
 			Client: mgr.GetClient(),
-			Log:    ctrl.Log.WithName("controllers").WithName("Workflow"),
+			Log:    ctrl.Log.WithName("controllers").WithName("WorkflowQuota"),
 			Scheme: mgr.GetScheme(),
 		}).SetupWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create controller", "controller", "Workflow")
+			setupLog.Error(err, "unable to create controller", "controller", "WorkflowQuota")
 			os.Exit(1)
 		}
 
@@ -107,39 +153,47 @@ func main() {
 			}
 		}
 	case "webhook":
-		if err = (&dwsv1alpha2.ClientMount{}).SetupWebhookWithManager(mgr); err != nil {
+		if auditSink != "" {
+			auditLogger := audit.NewSinkLogger(auditSink, auditFileOptions)
+			defer auditLogger.Close()
+
+			dwsv1alpha3.SetAuditLogger(auditLogger)
+			setupLog.Info("writing workflow audit records", "sink", auditSink)
+		}
+
+		if err = (&dwsv1alpha3.ClientMount{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "ClientMount")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.Computes{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.Computes{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Computes")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.DWDirectiveRule{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.DWDirectiveRule{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "DWDirectiveRule")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.DirectiveBreakdown{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.DirectiveBreakdown{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "DirectiveBreakdown")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.PersistentStorageInstance{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.PersistentStorageInstance{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "PersistentStorageInstance")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.Servers{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.Servers{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Servers")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.Storage{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.Storage{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Storage")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.SystemConfiguration{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.SystemConfiguration{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "SystemConfiguration")
 			os.Exit(1)
 		}
-		if err = (&dwsv1alpha2.Workflow{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&dwsv1alpha3.Workflow{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Workflow")
 			os.Exit(1)
 		}
@@ -32,24 +32,27 @@ import (
 
 	"github.com/go-logr/logr"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	"github.com/HewlettPackard/dws/utils/updater"
 )
 
 // ClientMountReconciler reconciles a ClientMount object
 type ClientMountReconciler struct {
 	client.Client
-	Mock    bool
-	Timeout time.Duration
-	Log     logr.Logger
-	Scheme  *runtime.Scheme
+	Mock     bool
+	Timeout  time.Duration
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 const (
@@ -57,15 +60,26 @@ const (
 	finalizerClientMount = "dws.cray.hpe.com/client_mount"
 )
 
+// Reasons for the events recorded against a ClientMount
+const (
+	EventReasonMounted               = "Mounted"
+	EventReasonMountFailed           = "MountFailed"
+	EventReasonUnmounted             = "Unmounted"
+	EventReasonUnmountFailed         = "UnmountFailed"
+	EventReasonLVMActivationFailed   = "LVMActivationFailed"
+	EventReasonLVMDeactivationFailed = "LVMDeactivationFailed"
+)
+
 //+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=clientmounts,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=clientmounts/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=dws.cray.hpe.com,resources=clientmounts/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 	log := r.Log.WithValues("ClientMount", req.NamespacedName)
-	clientMount := &dwsv1alpha2.ClientMount{}
+	clientMount := &dwsv1alpha3.ClientMount{}
 	if err := r.Get(ctx, req.NamespacedName, clientMount); err != nil {
 		// ignore not-found errors, since they can't be fixed by an immediate
 		// requeue (we'll need to wait for a new notification), and we can get them
@@ -75,7 +89,7 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Create a status updater that handles the call to r.Status().Update() if any of the fields
 	// in clientMount.Status{} change
-	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha2.ClientMountStatus](clientMount)
+	statusUpdater := updater.NewStatusUpdater[*dwsv1alpha3.ClientMountStatus](clientMount)
 	defer func() { err = statusUpdater.CloseWithStatusUpdate(ctx, r.Client.Status(), err) }()
 
 	// Handle cleanup if the resource is being deleted
@@ -98,9 +112,12 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// Bring the conditions in line with the rest of the status before it's written
+	defer func() { clientMount.Status.SetConditions(clientMount.Generation) }()
+
 	// Create the status section if it doesn't exist yet
 	if len(clientMount.Status.Mounts) != len(clientMount.Spec.Mounts) {
-		clientMount.Status.Mounts = make([]dwsv1alpha2.ClientMountInfoStatus, len(clientMount.Spec.Mounts))
+		clientMount.Status.Mounts = make([]dwsv1alpha3.ClientMountInfoStatus, len(clientMount.Spec.Mounts))
 	}
 
 	// Initialize the status section if the desired state doesn't match the status state
@@ -125,19 +142,19 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	clientMount.Status.Error = nil
 
-	if clientMount.Spec.DesiredState == dwsv1alpha2.ClientMountStateMounted {
+	if clientMount.Spec.DesiredState == dwsv1alpha3.ClientMountStateMounted {
 		err := r.mountAll(ctx, clientMount)
 		if err != nil {
-			resourceError := dwsv1alpha2.NewResourceError("Mount failed", err)
+			resourceError := dwsv1alpha3.NewResourceError("Mount failed", err)
 			log.Info(resourceError.Error())
 
 			clientMount.Status.Error = resourceError
 			return ctrl.Result{RequeueAfter: time.Second * time.Duration(10)}, nil
 		}
-	} else if clientMount.Spec.DesiredState == dwsv1alpha2.ClientMountStateUnmounted {
+	} else if clientMount.Spec.DesiredState == dwsv1alpha3.ClientMountStateUnmounted {
 		err := r.unmountAll(ctx, clientMount)
 		if err != nil {
-			resourceError := dwsv1alpha2.NewResourceError("Unmount failed", err)
+			resourceError := dwsv1alpha3.NewResourceError("Unmount failed", err)
 			log.Info(resourceError.Error())
 
 			clientMount.Status.Error = resourceError
@@ -149,18 +166,23 @@ func (r *ClientMountReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 // unmountAll unmounts all the file systems listed in the spec.Mounts list
-func (r *ClientMountReconciler) unmountAll(ctx context.Context, clientMount *dwsv1alpha2.ClientMount) error {
+func (r *ClientMountReconciler) unmountAll(ctx context.Context, clientMount *dwsv1alpha3.ClientMount) error {
 	log := r.Log.WithValues("ClientMount", types.NamespacedName{Name: clientMount.Name, Namespace: clientMount.Namespace})
 
 	var firstError error = nil
 	for i, mount := range clientMount.Spec.Mounts {
-		err := r.unmount(ctx, mount, log)
+		err := r.unmount(ctx, clientMount, mount, log)
 		if err != nil {
 			if firstError == nil {
 				firstError = err
 			}
+			r.Recorder.Eventf(clientMount, corev1.EventTypeWarning, EventReasonUnmountFailed, "Could not unmount %s: %v", mount.MountPath, err)
 			clientMount.Status.Mounts[i].Ready = false
 		} else {
+			// Only record the unmount the first time it succeeds
+			if !clientMount.Status.Mounts[i].Ready || clientMount.Status.Mounts[i].State != dwsv1alpha3.ClientMountStateUnmounted {
+				r.Recorder.Eventf(clientMount, corev1.EventTypeNormal, EventReasonUnmounted, "Unmounted %s", mount.MountPath)
+			}
 			clientMount.Status.Mounts[i].Ready = true
 		}
 	}
@@ -169,13 +191,13 @@ func (r *ClientMountReconciler) unmountAll(ctx context.Context, clientMount *dws
 }
 
 // unmount unmounts a single mount point described in the ClientMountInfo object
-func (r *ClientMountReconciler) unmount(ctx context.Context, clientMountInfo dwsv1alpha2.ClientMountInfo, log logr.Logger) error {
+func (r *ClientMountReconciler) unmount(ctx context.Context, clientMount *dwsv1alpha3.ClientMount, clientMountInfo dwsv1alpha3.ClientMountInfo, log logr.Logger) error {
 	state, err := r.checkMount(clientMountInfo.MountPath)
 	if err != nil {
 		return err
 	}
 
-	if state == dwsv1alpha2.ClientMountStateMounted {
+	if state == dwsv1alpha3.ClientMountStateMounted {
 
 		output, err := r.run("umount " + clientMountInfo.MountPath)
 		if err != nil {
@@ -190,9 +212,10 @@ func (r *ClientMountReconciler) unmount(ctx context.Context, clientMountInfo dws
 
 	}
 
-	if clientMountInfo.Device.Type == dwsv1alpha2.ClientMountDeviceTypeLVM {
+	if clientMountInfo.Device.Type == dwsv1alpha3.ClientMountDeviceTypeLVM {
 		if err := r.configureLVMDevice(clientMountInfo.Device.LVM, false, clientMountInfo.Type == "gfs2"); err != nil {
 			log.Error(err, "Could not deactivate LVM volume", "mount path", clientMountInfo.MountPath)
+			r.Recorder.Eventf(clientMount, corev1.EventTypeWarning, EventReasonLVMDeactivationFailed, "Could not deactivate LVM volume %s/%s: %v", clientMountInfo.Device.LVM.VolumeGroup, clientMountInfo.Device.LVM.LogicalVolume, err)
 			return err
 		}
 	}
@@ -202,18 +225,23 @@ func (r *ClientMountReconciler) unmount(ctx context.Context, clientMountInfo dws
 }
 
 // mountAll mounts all the file systems listed in the spec.Mounts list
-func (r *ClientMountReconciler) mountAll(ctx context.Context, clientMount *dwsv1alpha2.ClientMount) error {
+func (r *ClientMountReconciler) mountAll(ctx context.Context, clientMount *dwsv1alpha3.ClientMount) error {
 	log := r.Log.WithValues("ClientMount", types.NamespacedName{Name: clientMount.Name, Namespace: clientMount.Namespace})
 
 	var firstError error = nil
 	for i, mount := range clientMount.Spec.Mounts {
-		err := r.mount(ctx, mount, log)
+		err := r.mount(ctx, clientMount, mount, log)
 		if err != nil {
 			if firstError == nil {
 				firstError = err
 			}
+			r.Recorder.Eventf(clientMount, corev1.EventTypeWarning, EventReasonMountFailed, "Could not mount %s: %v", mount.MountPath, err)
 			clientMount.Status.Mounts[i].Ready = false
 		} else {
+			// Only record the mount the first time it succeeds
+			if !clientMount.Status.Mounts[i].Ready {
+				r.Recorder.Eventf(clientMount, corev1.EventTypeNormal, EventReasonMounted, "Mounted %s", mount.MountPath)
+			}
 			clientMount.Status.Mounts[i].Ready = true
 		}
 	}
@@ -222,7 +250,7 @@ func (r *ClientMountReconciler) mountAll(ctx context.Context, clientMount *dwsv1
 }
 
 // mount mounts a single mount point described in the ClientMountInfo object
-func (r *ClientMountReconciler) mount(ctx context.Context, clientMountInfo dwsv1alpha2.ClientMountInfo, log logr.Logger) error {
+func (r *ClientMountReconciler) mount(ctx context.Context, clientMount *dwsv1alpha3.ClientMount, clientMountInfo dwsv1alpha3.ClientMountInfo, log logr.Logger) error {
 
 	// Check whether the file system is already mounted
 	state, err := r.checkMount(clientMountInfo.MountPath)
@@ -230,13 +258,16 @@ func (r *ClientMountReconciler) mount(ctx context.Context, clientMountInfo dwsv1
 		return err
 	}
 
-	if state == dwsv1alpha2.ClientMountStateMounted {
+	if state == dwsv1alpha3.ClientMountStateMounted {
 		log.Info("Already mounted")
 		return nil
 	}
 
 	device, err := r.getDevice(clientMountInfo)
 	if err != nil {
+		if clientMountInfo.Device.Type == dwsv1alpha3.ClientMountDeviceTypeLVM {
+			r.Recorder.Eventf(clientMount, corev1.EventTypeWarning, EventReasonLVMActivationFailed, "Could not activate LVM volume %s/%s: %v", clientMountInfo.Device.LVM.VolumeGroup, clientMountInfo.Device.LVM.LogicalVolume, err)
+		}
 		return err
 	}
 
@@ -285,13 +316,13 @@ func (r *ClientMountReconciler) mount(ctx context.Context, clientMountInfo dwsv1
 }
 
 // getDevice builds the device string for the mount command. This is dependent on the type of file
-func (r *ClientMountReconciler) getDevice(clientMountInfo dwsv1alpha2.ClientMountInfo) (string, error) {
+func (r *ClientMountReconciler) getDevice(clientMountInfo dwsv1alpha3.ClientMountInfo) (string, error) {
 	switch clientMountInfo.Device.Type {
-	case dwsv1alpha2.ClientMountDeviceTypeLustre:
+	case dwsv1alpha3.ClientMountDeviceTypeLustre:
 		device := clientMountInfo.Device.Lustre.MgsAddresses + ":/" + clientMountInfo.Device.Lustre.FileSystemName
 
 		return device, nil
-	case dwsv1alpha2.ClientMountDeviceTypeLVM:
+	case dwsv1alpha3.ClientMountDeviceTypeLVM:
 		if err := r.configureLVMDevice(clientMountInfo.Device.LVM, true, clientMountInfo.Type == "gfs2"); err != nil {
 			return "", err
 		}
@@ -303,10 +334,10 @@ func (r *ClientMountReconciler) getDevice(clientMountInfo dwsv1alpha2.ClientMoun
 }
 
 // configureLVMDevice will configure the provided LVM device with the desired activate/deactivate option
-func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha2.ClientMountDeviceLVM, activate bool, shared bool) error {
+func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha3.ClientMountDeviceLVM, activate bool, shared bool) error {
 	output, err := r.run(fmt.Sprintf("lvs --noheadings --separator ' '"))
 	if err != nil {
-		return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not list storage").WithFatal()
+		return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not list storage").WithFatal()
 	}
 
 	if r.Mock {
@@ -340,7 +371,7 @@ func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha2.ClientMountD
 			if shared {
 				output, err := r.run(fmt.Sprintf("vgchange --lockstart %s", lvm.VolumeGroup))
 				if err != nil {
-					return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not access storage").WithFatal()
+					return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not access storage").WithFatal()
 				}
 
 				sharedOption = "s" // activate with shared option
@@ -349,13 +380,13 @@ func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha2.ClientMountD
 			// Activate the LV if needed
 			output, err := r.run(fmt.Sprintf("vgchange --activate %sy %s", sharedOption, lvm.VolumeGroup))
 			if err != nil {
-				return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not access storage").WithFatal()
+				return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not access storage").WithFatal()
 			}
 
 		} else if !activate && isActive {
 			output, err := r.run(fmt.Sprintf("vgchange --activate n %s", lvm.VolumeGroup))
 			if err != nil {
-				return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
+				return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
 			}
 		}
 
@@ -363,13 +394,13 @@ func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha2.ClientMountD
 			// Check whether the volume group has been locked and unlock it if necessary
 			output, err := r.run("lvmlockctl -i")
 			if err != nil {
-				return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
+				return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
 			}
 
 			if strings.Contains(output, fmt.Sprintf("VG %s", lvm.VolumeGroup)) {
 				output, err := r.run(fmt.Sprintf("vgchange --lockstop %s", lvm.VolumeGroup))
 				if err != nil {
-					return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
+					return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Client could not release storage").WithFatal()
 				}
 			}
 		}
@@ -386,7 +417,7 @@ func (r *ClientMountReconciler) configureLVMDevice(lvm *dwsv1alpha2.ClientMountD
 		return err
 	}
 
-	err = dwsv1alpha2.NewResourceError(fmt.Sprintf("Could not find VG/LV pair %s/%s", lvm.VolumeGroup, lvm.LogicalVolume)+": "+output, nil)
+	err = dwsv1alpha3.NewResourceError(fmt.Sprintf("Could not find VG/LV pair %s/%s", lvm.VolumeGroup, lvm.LogicalVolume)+": "+output, nil)
 	r.Log.Info(err.Error())
 
 	return err
@@ -407,29 +438,29 @@ func (r *ClientMountReconciler) rescanNVMeDevices() error {
 	}
 
 	if output, err := r.run("nvme ns-rescan " + strings.Join(nvmeDevices, " ")); err != nil {
-		return dwsv1alpha2.NewResourceError(output, err).WithUserMessage("Could not rescan NVMe devices").WithFatal()
+		return dwsv1alpha3.NewResourceError(output, err).WithUserMessage("Could not rescan NVMe devices").WithFatal()
 	}
 
 	return nil
 }
 
 // checkMount checks whether a file system is mounted at the path specified in "mountPath"
-func (r *ClientMountReconciler) checkMount(mountPath string) (dwsv1alpha2.ClientMountState, error) {
+func (r *ClientMountReconciler) checkMount(mountPath string) (dwsv1alpha3.ClientMountState, error) {
 	output, err := r.run("mount")
 	if err != nil {
-		return dwsv1alpha2.ClientMountStateUnmounted, dwsv1alpha2.NewResourceError(output, err)
+		return dwsv1alpha3.ClientMountStateUnmounted, dwsv1alpha3.NewResourceError(output, err)
 	}
 
 	for _, line := range strings.Split(output, "\n") {
 		fields := strings.Fields(line)
 		if len(fields) >= 3 {
 			if fields[2] == mountPath {
-				return dwsv1alpha2.ClientMountStateMounted, nil
+				return dwsv1alpha3.ClientMountStateMounted, nil
 			}
 		}
 	}
 
-	return dwsv1alpha2.ClientMountStateUnmounted, nil
+	return dwsv1alpha3.ClientMountStateUnmounted, nil
 }
 
 func (r *ClientMountReconciler) createFile(path string) error {
@@ -487,7 +518,7 @@ func filterByNonRabbitNamespacePrefixForTest() predicate.Predicate {
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClientMountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&dwsv1alpha2.ClientMount{})
+		For(&dwsv1alpha3.ClientMount{})
 
 	if _, found := os.LookupEnv("NNF_TEST_ENVIRONMENT"); found {
 		builder = builder.WithEventFilter(filterByNonRabbitNamespacePrefixForTest())
@@ -43,7 +43,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
-	dwsv1alpha2 "github.com/HewlettPackard/dws/api/v1alpha2"
+	dwsv1alpha3 "github.com/HewlettPackard/dws/api/v1alpha3"
 	"github.com/HewlettPackard/dws/mount-daemon/controllers"
 	"github.com/HewlettPackard/dws/mount-daemon/version"
 	//+kubebuilder:scaffold:imports
@@ -65,7 +65,7 @@ type Service struct {
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
-	utilruntime.Must(dwsv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(dwsv1alpha3.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -231,11 +231,12 @@ func startManager(config *managerConfig) {
 	}
 
 	if err = (&controllers.ClientMountReconciler{
-		Client:  mgr.GetClient(),
-		Log:     ctrl.Log.WithName("controllers").WithName("ClientMount"),
-		Mock:    config.mock,
-		Timeout: config.timeout,
-		Scheme:  mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ClientMount"),
+		Mock:     config.mock,
+		Timeout:  config.timeout,
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("clientmountd"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClientMount")
 		os.Exit(1)
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit writes an audit log of Workflow changes as JSON records, one per line. The records
+// are written by the validating webhook, so each one records an admission decision. The API
+// server may still fail to store the change after the webhook admits it.
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Actions recorded in the audit log
+const (
+	ActionCreate             = "Create"
+	ActionDesiredStateChange = "DesiredStateChange"
+	ActionHurryChange        = "HurryChange"
+	ActionDriverCompleted    = "DriverCompleted"
+	ActionDriverError        = "DriverError"
+	ActionDelete             = "Delete"
+)
+
+// Decisions recorded in the audit log
+const (
+	// DecisionAdmitted is recorded when the validating webhook admits the request. The change
+	// isn't committed until the API server stores it, which may still fail.
+	DecisionAdmitted = "Admitted"
+)
+
+// StdoutSink is the sink name that writes the audit log to stdout
+const StdoutSink = "stdout"
+
+// Record is a single audit log entry
+type Record struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+
+	// Decision is the admission decision for the request that makes the change
+	Decision string `json:"decision"`
+
+	// RequestUID is the UID of the admission request, which is also recorded for the request
+	// in the API server's audit log
+	RequestUID string `json:"requestUID,omitempty"`
+
+	// User and Groups come from the userInfo of the admission request
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	WLMID     string `json:"wlmID,omitempty"`
+	JobID     string `json:"jobID,omitempty"`
+
+	// State is the workflow's state when the record was written
+	State string `json:"state,omitempty"`
+
+	// From and To are the old and new values of a changed field
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+
+	// Driver fields are set for the driver actions
+	DriverID   string `json:"driverID,omitempty"`
+	DWDIndex   *int   `json:"dwdIndex,omitempty"`
+	WatchState string `json:"watchState,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	// Forced is set for a delete that used the force delete annotation
+	Forced bool `json:"forced,omitempty"`
+}
+
+// Logger writes the records to its sink
+type Logger struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// NewLogger returns a logger that writes to w
+func NewLogger(w io.Writer) *Logger {
+	l := &Logger{encoder: json.NewEncoder(w)}
+	if closer, ok := w.(io.Closer); ok {
+		l.closer = closer
+	}
+
+	return l
+}
+
+// FileOptions control the rotation of an audit log file
+type FileOptions struct {
+	// MaxSizeMB is the size of the file before it's rotated
+	MaxSizeMB int
+
+	// MaxBackups is the number of rotated files to keep. Zero keeps them all.
+	MaxBackups int
+
+	// MaxAgeDays is the number of days to keep the rotated files. Zero keeps them regardless of age.
+	MaxAgeDays int
+}
+
+// NewSinkLogger returns a logger for the sink, which is either StdoutSink or the path of a
+// file that is rotated according to the options
+func NewSinkLogger(sink string, options FileOptions) *Logger {
+	if sink == StdoutSink {
+		return &Logger{encoder: json.NewEncoder(os.Stdout)}
+	}
+
+	return NewLogger(&lumberjack.Logger{
+		Filename:   sink,
+		MaxSize:    options.MaxSizeMB,
+		MaxBackups: options.MaxBackups,
+		MaxAge:     options.MaxAgeDays,
+	})
+}
+
+// Log writes the records. A record without a time is given the current time.
+func (l *Logger) Log(records ...Record) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now().UTC()
+	for _, record := range records {
+		if record.Time.IsZero() {
+			record.Time = now
+		}
+
+		if err := l.encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes the sink if it's a file
+func (l *Logger) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+
+	return l.closer.Close()
+}
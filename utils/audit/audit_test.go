@@ -0,0 +1,91 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Audit Test")
+}
+
+// readRecords decodes the records written one per line
+func readRecords(data []byte) []Record {
+	records := []Record{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		record := Record{}
+		Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+		records = append(records, record)
+	}
+
+	return records
+}
+
+var _ = Describe("Audit Test", func() {
+
+	It("Writes a JSON record per line", func() {
+		buffer := &bytes.Buffer{}
+		logger := NewLogger(buffer)
+
+		index := 1
+		Expect(logger.Log(
+			Record{Action: ActionDesiredStateChange, User: "wlm", Namespace: "default", Name: "w", From: "Proposal", To: "Setup"},
+			Record{Action: ActionDriverError, User: "driver", Namespace: "default", Name: "w", DriverID: "d", DWDIndex: &index, Error: "failed"},
+		)).To(Succeed())
+
+		records := readRecords(buffer.Bytes())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Action).To(Equal(ActionDesiredStateChange))
+		Expect(records[0].To).To(Equal("Setup"))
+		Expect(records[0].Time.IsZero()).To(BeFalse())
+		Expect(records[1].DriverID).To(Equal("d"))
+		Expect(*records[1].DWDIndex).To(Equal(1))
+
+		Expect(logger.Close()).To(Succeed())
+	})
+
+	It("Writes to a file sink", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		logger := NewSinkLogger(path, FileOptions{MaxSizeMB: 1})
+
+		Expect(logger.Log(Record{Action: ActionDelete, User: "admin", Namespace: "default", Name: "w", Forced: true})).To(Succeed())
+		Expect(logger.Close()).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		records := readRecords(data)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Forced).To(BeTrue())
+	})
+})
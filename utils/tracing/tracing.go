@@ -0,0 +1,213 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing exports a distributed trace for each Workflow through OpenTelemetry.
+//
+// A Workflow's spans are recorded after the fact with explicit timestamps because a state
+// spans many reconciles, and possibly many controller restarts. The W3C traceparent of the
+// Workflow's root span is kept on the Workflow so that each recorder, including drivers and
+// WLM plugins, adds its spans to the same trace. The root span itself is recorded when the
+// Workflow finishes, reusing the span ID from the traceparent.
+package tracing
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the instrumentation name of the DWS spans
+	TracerName = "github.com/HewlettPackard/dws"
+
+	// ServiceName is the service name of the DWS spans
+	ServiceName = "dws"
+
+	traceParentKey = "traceparent"
+)
+
+var (
+	propagator = propagation.TraceContext{}
+	ids        = newIDGenerator()
+)
+
+// NewOTLPExporter returns an exporter that sends spans to the OTLP/HTTP endpoint (host:port)
+func NewOTLPExporter(ctx context.Context, endpoint string, insecure bool) (sdktrace.SpanExporter, error) {
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+
+	return otlptracehttp.New(ctx, options...)
+}
+
+// Setup installs a global tracer provider with the options, which should include a span
+// processor such as sdktrace.WithBatcher(exporter). Tracing is a no-op until Setup is called.
+// The caller should shut down the provider on exit to flush the remaining spans.
+func Setup(options ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	options = append([]sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+		sdktrace.WithIDGenerator(ids),
+	}, options...)
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+
+	return provider
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// NewTraceParent returns the W3C traceparent of the root span of a new, sampled trace
+func NewTraceParent() string {
+	traceID, spanID := ids.NewIDs(context.Background())
+
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	return carrier.Get(traceParentKey)
+}
+
+// ContextWithTraceParent returns a context whose parent is the span in the traceparent.
+// Drivers use this to add their spans to a Workflow's trace.
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier{traceParentKey: traceParent})
+}
+
+// Span describes a span that has already finished
+type Span struct {
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes []attribute.KeyValue
+	Events     []Event
+
+	// Error marks the span as failed with this message when it isn't empty
+	Error string
+}
+
+// Event describes something that happened during a span
+type Event struct {
+	Name       string
+	Time       time.Time
+	Attributes []attribute.KeyValue
+}
+
+// RecordSpan records the span as a child of the span in the traceparent. Nothing is
+// recorded if the traceparent isn't valid.
+func RecordSpan(ctx context.Context, traceParent string, span Span) {
+	ctx = ContextWithTraceParent(ctx, traceParent)
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	record(ctx, span)
+}
+
+// RecordRootSpan records the root span identified by the traceparent. Nothing is recorded
+// if the traceparent isn't valid.
+func RecordRootSpan(ctx context.Context, traceParent string, span Span) {
+	parent := trace.SpanContextFromContext(ContextWithTraceParent(ctx, traceParent))
+	if !parent.IsValid() {
+		return
+	}
+
+	// Start the span as a new root, but with the IDs from the traceparent
+	record(context.WithValue(ctx, presetIDsKey{}, parent), span, trace.WithNewRoot())
+}
+
+func record(ctx context.Context, span Span, options ...trace.SpanStartOption) {
+	options = append(options, trace.WithTimestamp(span.Start), trace.WithAttributes(span.Attributes...))
+	_, s := tracer().Start(ctx, span.Name, options...)
+
+	for _, event := range span.Events {
+		s.AddEvent(event.Name, trace.WithTimestamp(event.Time), trace.WithAttributes(event.Attributes...))
+	}
+
+	if len(span.Error) > 0 {
+		s.SetStatus(codes.Error, span.Error)
+	}
+
+	s.End(trace.WithTimestamp(span.End))
+}
+
+// presetIDsKey is the context key of the span context whose IDs the next root span uses
+type presetIDsKey struct{}
+
+// idGenerator creates random trace and span IDs like the SDK's default generator, except
+// that a root span started with preset IDs in its context gets those IDs.
+type idGenerator struct {
+	sync.Mutex
+	random *rand.Rand
+}
+
+func newIDGenerator() *idGenerator {
+	var seed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &seed)
+
+	return &idGenerator{random: rand.New(rand.NewSource(seed))}
+}
+
+func (gen *idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if preset, ok := ctx.Value(presetIDsKey{}).(trace.SpanContext); ok {
+		return preset.TraceID(), preset.SpanID()
+	}
+
+	gen.Lock()
+	defer gen.Unlock()
+
+	traceID := trace.TraceID{}
+	_, _ = gen.random.Read(traceID[:])
+	spanID := trace.SpanID{}
+	_, _ = gen.random.Read(spanID[:])
+
+	return traceID, spanID
+}
+
+func (gen *idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	gen.Lock()
+	defer gen.Unlock()
+
+	spanID := trace.SpanID{}
+	_, _ = gen.random.Read(spanID[:])
+
+	return spanID
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2023 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Tracing Test")
+}
+
+var _ = Describe("Tracing Test", func() {
+
+	var (
+		exporter *tracetest.InMemoryExporter
+		provider *sdktrace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		provider = Setup(sdktrace.WithSyncer(exporter))
+	})
+
+	AfterEach(func() {
+		Expect(provider.Shutdown(context.TODO())).To(Succeed())
+	})
+
+	It("Records the root and child spans in the same trace", func() {
+		traceParent := NewTraceParent()
+		root := trace.SpanContextFromContext(ContextWithTraceParent(context.TODO(), traceParent))
+		Expect(root.IsValid()).To(BeTrue())
+		Expect(root.IsSampled()).To(BeTrue())
+
+		start := time.Now().Add(-time.Minute)
+		end := time.Now()
+
+		RecordSpan(context.TODO(), traceParent, Span{
+			Name:  "Proposal",
+			Start: start,
+			End:   end,
+			Events: []Event{
+				{Name: "driver registered", Time: start, Attributes: []attribute.KeyValue{attribute.String("dws.driver.id", "driver")}},
+			},
+			Error: "driver failed",
+		})
+
+		RecordRootSpan(context.TODO(), traceParent, Span{
+			Name:  "workflow",
+			Start: start,
+			End:   end,
+		})
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+
+		child := spans[0]
+		Expect(child.Name).To(Equal("Proposal"))
+		Expect(child.SpanContext.TraceID()).To(Equal(root.TraceID()))
+		Expect(child.Parent.SpanID()).To(Equal(root.SpanID()))
+		Expect(child.StartTime).To(BeTemporally("==", start))
+		Expect(child.EndTime).To(BeTemporally("==", end))
+		Expect(child.Events).To(HaveLen(1))
+		Expect(child.Events[0].Name).To(Equal("driver registered"))
+		Expect(child.Status.Code).To(Equal(codes.Error))
+
+		rootSpan := spans[1]
+		Expect(rootSpan.Name).To(Equal("workflow"))
+		Expect(rootSpan.SpanContext.TraceID()).To(Equal(root.TraceID()))
+		Expect(rootSpan.SpanContext.SpanID()).To(Equal(root.SpanID()))
+		Expect(rootSpan.Parent.IsValid()).To(BeFalse())
+	})
+
+	It("Ignores an invalid traceparent", func() {
+		RecordSpan(context.TODO(), "", Span{Name: "Proposal"})
+		RecordRootSpan(context.TODO(), "not a traceparent", Span{Name: "workflow"})
+
+		Expect(exporter.GetSpans()).To(BeEmpty())
+	})
+})
@@ -40,6 +40,7 @@ type resource[T any] interface {
 type statusUpdater[T any] struct {
 	resource resource[T]
 	status   T
+	onUpdate []func()
 }
 
 // NewStatusUpdater returns a status updater meant for updating the status of the
@@ -86,12 +87,28 @@ func (updater *statusUpdater[S]) CloseWithStatusUpdate(ctx context.Context, c cl
 	return updater.close(ctx, func() error { return c.Update(ctx, updater.resource) }, err)
 }
 
+// OnUpdate adds a function that is called when the updater is closed and the status has been
+// written, or didn't need to be. It isn't called if the status couldn't be written, so anything
+// that reports the new status, such as metrics, isn't reported again when the reconcile is retried.
+func (updater *statusUpdater[S]) OnUpdate(f func()) {
+	updater.onUpdate = append(updater.onUpdate, f)
+}
+
+func (updater *statusUpdater[S]) updated() {
+	for _, f := range updater.onUpdate {
+		f()
+	}
+}
+
 func (updater *statusUpdater[S]) close(ctx context.Context, updateFunc func() error, err error) error {
 	if !reflect.DeepEqual(updater.resource.GetStatus(), updater.status) {
 
 		// Always attempt an update to the resource even in the presence of different error, but
 		// do not override the original error if present.
 		updateError := updateFunc()
+		if updateError == nil {
+			updater.updated()
+		}
 
 		if err == nil {
 			// Do not return an error if there is a resource conflict on this version of the resource.
@@ -105,5 +122,7 @@ func (updater *statusUpdater[S]) close(ctx context.Context, updateFunc func() er
 
 	}
 
+	updater.updated()
+
 	return err
 }
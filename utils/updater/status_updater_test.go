@@ -132,6 +132,40 @@ func testStatusUpdate(t *testing.T, changed bool, err error) {
 	}
 }
 
+type failingStatusWriter struct {
+	client.SubResourceWriter
+}
+
+func (*failingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return errors.Errorf("update failed")
+}
+
+// Test that the OnUpdate functions are only called when the status is written
+func TestOnUpdate(t *testing.T) {
+	obj := &testObject{updated: false}
+	updater := NewStatusUpdater[*testStatus](obj)
+
+	called := false
+	updater.OnUpdate(func() { called = true })
+	obj.status.changed = true
+
+	if err := updater.CloseWithStatusUpdate(context.TODO(), &failingStatusWriter{}, nil); err == nil {
+		t.Errorf("Close expected an error")
+	}
+
+	if called {
+		t.Errorf("OnUpdate function called when the status wasn't written")
+	}
+
+	if err := updater.CloseWithStatusUpdate(context.TODO(), &testStatusWriter{}, nil); err != nil {
+		t.Errorf("Close expected no error, not %v", err)
+	}
+
+	if !called {
+		t.Errorf("OnUpdate function not called when the status was written")
+	}
+}
+
 // Just touch ginkgo, so it's here to interpret any ginkgo args from
 // "make test", so that doesn't fail on this test file.
 var _ = BeforeSuite(func() {})